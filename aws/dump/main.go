@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"os/signal"
 	"strings"
 
 	"github.com/aws/aws-lambda-go/lambda"
@@ -22,8 +23,10 @@ var (
 	outputFilename                 = kingpin.Flag("output", "Filename to store the results in.").Short('o').String()
 	onlyUnmanaged                  = kingpin.Flag("only-unmanaged", "Only return resources not managed by terraform.").Default("false").Bool()
 	reports                        = kingpin.Flag("report", "Only run the specified report. Can be repeated.").Strings()
+	services                       = kingpin.Flag("service", "Only run the reports of the specified service. Can be repeated.").Strings()
 	listReports                    = kingpin.Flag("list-reports", "Prints the list of available reports and exits.").Default("false").Bool()
 	startAsLambda                  = kingpin.Flag("start-as-lambda", "Start as lambda.").Default("false").Bool()
+	verbose                        = kingpin.Flag("verbose", "Log the progress of the reports.").Default("false").Bool()
 )
 
 type Input struct {
@@ -31,6 +34,8 @@ type Input struct {
 	TerraformBackendConfig *TerraformBackends   `json:"terraform_backend_config"`
 	OnlyUnmanaged          bool                 `json:"only_unmanaged"`
 	Reports                []string             `json:"reports"`
+	Services               []string             `json:"services"`
+	Verbose                bool                 `json:"verbose"`
 }
 
 type Output struct {
@@ -46,11 +51,31 @@ func Handler() func(ctx context.Context, event Input) (*Output, error) {
 			return nil, err
 		}
 
-		services := resources.AllServices()
+		options := resources.DefaultDumpOptions()
+		if event.Verbose {
+			options.Logger = log.StandardLogger()
+		}
+		for _, account := range event.Accounts {
+			for _, session := range account.Sessions {
+				session.Options = options
+			}
+		}
 
 		jobs := []resources.Job{}
 
 		if len(event.Reports) == 0 {
+			services := resources.AllServices()
+			if len(event.Services) != 0 {
+				services = []resources.Service{}
+				for _, name := range event.Services {
+					service, ok := resources.GetService(name)
+					if !ok {
+						common.Fatalln(fmt.Sprintf("Invalid service %s", name))
+					}
+					services = append(services, service)
+				}
+			}
+
 			for _, service := range services {
 				for _, account := range event.Accounts {
 					newJobs, err := service.GenerateAllJobs(account)
@@ -66,7 +91,7 @@ func Handler() func(ctx context.Context, event Input) (*Output, error) {
 					common.Fatalln(fmt.Sprintf("Invalid report format %s, should be service:resource", name))
 				}
 
-				service, ok := services[parts[0]]
+				service, ok := resources.GetService(parts[0])
 				if !ok {
 					common.Fatalln(fmt.Sprintf("Invalid service %s", parts[0]))
 				}
@@ -79,7 +104,7 @@ func Handler() func(ctx context.Context, event Input) (*Output, error) {
 			}
 		}
 
-		result, errors := resources.Run(jobs)
+		result, errors := resources.Run(ctx, jobs)
 
 		if event.TerraformBackendConfig != nil {
 
@@ -90,8 +115,12 @@ func Handler() func(ctx context.Context, event Input) (*Output, error) {
 			common.FatalOnErrorW(err, "failed to load terraform state files")
 
 			for _, resource := range result {
-				s3Path, managed := managed[resource.UniqueID()]
-				if managed {
+				s3Path, isManaged := managed[resource.UniqueID()]
+				if !isManaged {
+					// terraform doesn't expose an ARN for some resources like access keys
+					s3Path, isManaged = managed[resource.ID]
+				}
+				if isManaged {
 					if event.OnlyUnmanaged {
 						continue
 					}
@@ -143,7 +172,12 @@ func main() {
 		input := Input{
 			Accounts:      accounts,
 			Reports:       *reports,
+			Services:      *services,
 			OnlyUnmanaged: *onlyUnmanaged,
+			Verbose:       *verbose,
+		}
+		if *verbose && log.GetLevel() < log.InfoLevel {
+			log.SetLevel(log.InfoLevel)
 		}
 
 		if *terraformBackendConfigFilename != "" {
@@ -153,7 +187,10 @@ func main() {
 			input.TerraformBackendConfig = backends
 		}
 
-		output, err := handler(context.Background(), input)
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+
+		output, err := handler(ctx, input)
 		common.FatalOnErrorW(err, "handler failed")
 
 		reportJSON, err := json.MarshalIndent(output.Resources, "", "  ")
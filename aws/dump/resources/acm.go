@@ -1,6 +1,9 @@
 package resources
 
 import (
+	"context"
+	"time"
+
 	"github.com/aws/aws-sdk-go/service/acm"
 )
 
@@ -13,23 +16,43 @@ var (
 	}
 )
 
-func ACMListCertificates(session *Session) *ReportResult {
-	client := acm.New(session.Session, session.Config)
+func init() {
+	RegisterService(ACMService)
+}
+
+const ACMExpiringSoonWindow = 30 * 24 * time.Hour
+
+func ACMListCertificates(ctx context.Context, session *Session) *ReportResult {
+	client := session.ACMClient()
 
 	result := &ReportResult{}
-	result.Error = client.ListCertificatesPages(&acm.ListCertificatesInput{},
+	err := client.ListCertificatesPagesWithContext(ctx, &acm.ListCertificatesInput{},
 		func(page *acm.ListCertificatesOutput, lastPage bool) bool {
-			for _, certificate := range page.CertificateSummaryList {
+			for _, summary := range page.CertificateSummaryList {
+				described, err := client.DescribeCertificateWithContext(ctx, &acm.DescribeCertificateInput{
+					CertificateArn: summary.CertificateArn,
+				})
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				certificate := described.Certificate
+
 				resource, err := NewResource(*certificate.CertificateArn, certificate)
 				if err != nil {
-					result.Error = err
-					return false
+					result.AddError(err)
+					continue
 				}
+				resource.Type = "certificate"
+				resource.Metadata["InUse"] = len(certificate.InUseBy) > 0
+				resource.Metadata["ExpiringSoon"] = certificate.NotAfter != nil &&
+					time.Until(*certificate.NotAfter) < ACMExpiringSoonWindow
 				result.Resources = append(result.Resources, *resource)
 			}
 
 			return true
 		})
 
+	result.AddError(err)
 	return result
 }
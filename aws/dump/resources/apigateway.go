@@ -0,0 +1,178 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/fatih/structs"
+)
+
+var (
+	APIGatewayService = Service{
+		Name: "apigateway",
+		Reports: map[string]Report{
+			"rest-apis": APIGatewayListRestAPIs,
+			"http-apis": APIGatewayListHTTPAPIs,
+		},
+	}
+)
+
+func init() {
+	RegisterService(APIGatewayService)
+}
+
+func APIGatewayListRestAPIs(ctx context.Context, session *Session) *ReportResult {
+	client := session.APIGatewayClient()
+
+	result := &ReportResult{}
+	err := client.GetRestApisPagesWithContext(ctx, &apigateway.GetRestApisInput{},
+		func(page *apigateway.GetRestApisOutput, lastPage bool) bool {
+			for _, api := range page.Items {
+				resource := Resource{
+					ID:        *api.Id,
+					ARN:       BuildARN(session.Partition(), "apigateway", *session.Config.Region, "", fmt.Sprintf("/restapis/%s", *api.Id)),
+					AccountID: session.AccountID,
+					Service:   "apigateway",
+					Type:      "rest-api",
+					Region:    *session.Config.Region,
+					Metadata:  structs.Map(api),
+				}
+
+				if api.EndpointConfiguration != nil {
+					resource.Metadata["EndpointTypes"] = aws.StringValueSlice(api.EndpointConfiguration.Types)
+				}
+
+				resource.Metadata["Policy"] = nil
+				if api.Policy != nil && *api.Policy != "" {
+					// the policy is returned as a JSON string with escaped quotes
+					document, err := DecodeInlinePolicyDocument(strings.ReplaceAll(*api.Policy, `\"`, `"`))
+					if err != nil {
+						result.AddError(err)
+					} else {
+						resource.Metadata["Policy"] = document
+					}
+				}
+
+				stages, err := client.GetStagesWithContext(ctx, &apigateway.GetStagesInput{RestApiId: api.Id})
+				if err != nil {
+					result.AddError(err)
+					resource.Metadata["Error"] = err.Error()
+				} else {
+					names := []string{}
+					for _, stage := range stages.Item {
+						names = append(names, aws.StringValue(stage.StageName))
+					}
+					sort.Strings(names)
+					resource.Metadata["StageNames"] = names
+				}
+
+				result.Resources = append(result.Resources, resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
+
+func APIGatewayListHTTPAPIs(ctx context.Context, session *Session) *ReportResult {
+	client := session.APIGatewayV2Client()
+
+	result := &ReportResult{}
+	input := &apigatewayv2.GetApisInput{}
+	for {
+		page, err := client.GetApisWithContext(ctx, input)
+		if err != nil {
+			result.AddError(err)
+			return result
+		}
+
+		for _, api := range page.Items {
+			resource := Resource{
+				ID:        *api.ApiId,
+				ARN:       BuildARN(session.Partition(), "apigateway", *session.Config.Region, "", fmt.Sprintf("/apis/%s", *api.ApiId)),
+				AccountID: session.AccountID,
+				Service:   "apigateway",
+				Type:      "http-api",
+				Region:    *session.Config.Region,
+				Metadata:  structs.Map(api),
+			}
+
+			err := apiGatewayV2Details(ctx, client, *api.ApiId, resource.Metadata)
+			if err != nil {
+				result.AddError(err)
+				resource.Metadata["Error"] = err.Error()
+			}
+
+			result.Resources = append(result.Resources, resource)
+		}
+
+		if page.NextToken == nil {
+			return result
+		}
+		input.NextToken = page.NextToken
+	}
+}
+
+func apiGatewayV2Details(ctx context.Context, client *apigatewayv2.ApiGatewayV2, apiID string, metadata map[string]interface{}) error {
+	routeCount := 0
+	routesInput := &apigatewayv2.GetRoutesInput{ApiId: aws.String(apiID)}
+	for {
+		routes, err := client.GetRoutesWithContext(ctx, routesInput)
+		if err != nil {
+			return err
+		}
+		routeCount += len(routes.Items)
+		if routes.NextToken == nil {
+			break
+		}
+		routesInput.NextToken = routes.NextToken
+	}
+	metadata["RouteCount"] = routeCount
+
+	authorizerTypes := map[string]bool{}
+	authorizersInput := &apigatewayv2.GetAuthorizersInput{ApiId: aws.String(apiID)}
+	for {
+		authorizers, err := client.GetAuthorizersWithContext(ctx, authorizersInput)
+		if err != nil {
+			return err
+		}
+		for _, authorizer := range authorizers.Items {
+			authorizerTypes[aws.StringValue(authorizer.AuthorizerType)] = true
+		}
+		if authorizers.NextToken == nil {
+			break
+		}
+		authorizersInput.NextToken = authorizers.NextToken
+	}
+	types := []string{}
+	for authorizerType := range authorizerTypes {
+		types = append(types, authorizerType)
+	}
+	sort.Strings(types)
+	metadata["AuthorizerTypes"] = types
+
+	stageNames := []string{}
+	stagesInput := &apigatewayv2.GetStagesInput{ApiId: aws.String(apiID)}
+	for {
+		stages, err := client.GetStagesWithContext(ctx, stagesInput)
+		if err != nil {
+			return err
+		}
+		for _, stage := range stages.Items {
+			stageNames = append(stageNames, aws.StringValue(stage.StageName))
+		}
+		if stages.NextToken == nil {
+			break
+		}
+		stagesInput.NextToken = stages.NextToken
+	}
+	sort.Strings(stageNames)
+	metadata["StageNames"] = stageNames
+	return nil
+}
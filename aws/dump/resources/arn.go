@@ -0,0 +1,39 @@
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+func PartitionForRegion(region string) string {
+	if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok {
+		return partition.ID()
+	}
+
+	switch {
+	case strings.HasPrefix(region, "us-gov-"):
+		return endpoints.AwsUsGovPartitionID
+	case strings.HasPrefix(region, "cn-"):
+		return endpoints.AwsCnPartitionID
+	case strings.HasPrefix(region, "us-isob-"):
+		return endpoints.AwsIsoBPartitionID
+	case strings.HasPrefix(region, "us-iso-"):
+		return endpoints.AwsIsoPartitionID
+	}
+	return endpoints.AwsPartitionID
+}
+
+func BuildARN(partition, service, region, account, resource string) string {
+	return fmt.Sprintf("arn:%s:%s:%s:%s:%s", partition, service, region, account, resource)
+}
+
+func (s *Session) Partition() string {
+	return PartitionForRegion(*s.Config.Region)
+}
+
+// Pseudo region used for resources of global services like IAM.
+func GlobalRegion(partition string) string {
+	return fmt.Sprintf("%s-global", partition)
+}
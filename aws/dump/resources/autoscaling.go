@@ -1,6 +1,9 @@
 package resources
 
 import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/fatih/structs"
 )
@@ -15,12 +18,16 @@ var (
 	}
 )
 
-func AutoScalingListGroups(session *Session) *ReportResult {
+func init() {
+	RegisterService(AutoScalingService)
+}
+
+func AutoScalingListGroups(ctx context.Context, session *Session) *ReportResult {
 
-	client := autoscaling.New(session.Session, session.Config)
+	client := session.AutoScalingClient()
 
 	resources := []Resource{}
-	err := client.DescribeAutoScalingGroupsPages(&autoscaling.DescribeAutoScalingGroupsInput{},
+	err := client.DescribeAutoScalingGroupsPagesWithContext(ctx, &autoscaling.DescribeAutoScalingGroupsInput{},
 		func(page *autoscaling.DescribeAutoScalingGroupsOutput, lastPage bool) bool {
 			for _, autoScalingGroup := range page.AutoScalingGroups {
 				resource := Resource{
@@ -32,21 +39,67 @@ func AutoScalingListGroups(session *Session) *ReportResult {
 					Region:    *session.Config.Region,
 					Metadata:  structs.Map(autoScalingGroup),
 				}
+
+				instances := []map[string]interface{}{}
+				for _, instance := range autoScalingGroup.Instances {
+					instances = append(instances, map[string]interface{}{
+						"InstanceId":     *instance.InstanceId,
+						"LifecycleState": *instance.LifecycleState,
+					})
+				}
+				resource.Metadata["InstanceStates"] = instances
+				resource.Metadata["LaunchReference"] = autoScalingLaunchReference(autoScalingGroup)
+				resource.Metadata["TargetGroups"] = aws.StringValueSlice(autoScalingGroup.TargetGroupARNs)
+				resource.Metadata["TagsMap"] = AutoScalingTagsToMap(autoScalingGroup.Tags)
+
 				resources = append(resources, resource)
 			}
 
 			return true
 		})
 
-	return &ReportResult{resources, err}
+	return &ReportResult{Resources: resources, Error: err}
+}
+
+func autoScalingLaunchReference(group *autoscaling.Group) map[string]interface{} {
+	var template *autoscaling.LaunchTemplateSpecification
+	switch {
+	case group.LaunchConfigurationName != nil:
+		return map[string]interface{}{
+			"Type": "launch-configuration",
+			"Name": *group.LaunchConfigurationName,
+		}
+	case group.LaunchTemplate != nil:
+		template = group.LaunchTemplate
+	case group.MixedInstancesPolicy != nil && group.MixedInstancesPolicy.LaunchTemplate != nil:
+		template = group.MixedInstancesPolicy.LaunchTemplate.LaunchTemplateSpecification
+	}
+	if template == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"Type":    "launch-template",
+		"Id":      aws.StringValue(template.LaunchTemplateId),
+		"Name":    aws.StringValue(template.LaunchTemplateName),
+		"Version": aws.StringValue(template.Version),
+	}
+}
+
+func AutoScalingTagsToMap(tags []*autoscaling.TagDescription) map[string]string {
+	tagsMap := map[string]string{}
+	for _, tag := range tags {
+		tagsMap[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return tagsMap
 }
 
-func AutoScalingListLaunchConfigurations(session *Session) *ReportResult {
+func AutoScalingListLaunchConfigurations(ctx context.Context, session *Session) *ReportResult {
 
-	client := autoscaling.New(session.Session, session.Config)
+	client := session.AutoScalingClient()
 
 	resources := []Resource{}
-	err := client.DescribeLaunchConfigurationsPages(&autoscaling.DescribeLaunchConfigurationsInput{},
+	err := client.DescribeLaunchConfigurationsPagesWithContext(ctx, &autoscaling.DescribeLaunchConfigurationsInput{},
 		func(page *autoscaling.DescribeLaunchConfigurationsOutput, lastPage bool) bool {
 			for _, launchConfiguration := range page.LaunchConfigurations {
 				resource := Resource{
@@ -64,5 +117,5 @@ func AutoScalingListLaunchConfigurations(session *Session) *ReportResult {
 			return true
 		})
 
-	return &ReportResult{resources, err}
+	return &ReportResult{Resources: resources, Error: err}
 }
@@ -3,11 +3,16 @@ package resources
 import (
 	"encoding/json"
 	"io/ioutil"
+	"strings"
+	"sync"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/hamstah/awstools/common"
+	"github.com/pkg/errors"
 )
 
 type Account struct {
@@ -20,9 +25,162 @@ type Account struct {
 }
 
 type Session struct {
-	Session   *session.Session
-	Config    *aws.Config
-	AccountID string
+	Session         *session.Session
+	Config          *aws.Config
+	AccountID       string
+	AccountAlias    string
+	Sink            ResourceSink
+	RoleSessionName string
+	Options         *DumpOptions
+
+	accountIDMutex       sync.Mutex
+	accountAliasResolved bool
+	// reportName is set while running a report, for progress callbacks
+	reportName string
+}
+
+func (s *Session) ResolveAccountID() (string, error) {
+	s.accountIDMutex.Lock()
+	defer s.accountIDMutex.Unlock()
+
+	if s.AccountID != "" {
+		return s.AccountID, nil
+	}
+
+	stsClient := s.STSClient()
+	identity, err := stsClient.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", err
+	}
+	s.AccountID = *identity.Account
+	return s.AccountID, nil
+}
+
+// ResolveAccountAlias looks up the account alias once, accounts without one
+// keep an empty alias.
+func (s *Session) ResolveAccountAlias() (string, error) {
+	s.accountIDMutex.Lock()
+	defer s.accountIDMutex.Unlock()
+
+	if s.accountAliasResolved || s.AccountAlias != "" || s.Session == nil {
+		return s.AccountAlias, nil
+	}
+	// don't retry on every report when the alias can't be listed
+	s.accountAliasResolved = true
+
+	iamClient := s.IAMClient()
+	aliases, err := iamClient.ListAccountAliases(&iam.ListAccountAliasesInput{})
+	if err != nil {
+		return "", err
+	}
+	if len(aliases.AccountAliases) > 0 {
+		s.AccountAlias = *aliases.AccountAliases[0]
+	}
+	return s.AccountAlias, nil
+}
+
+func (s *Session) ForRegion(region string) *Session {
+	config := s.Config.Copy().WithRegion(region)
+
+	var sess *session.Session
+	if s.Session != nil {
+		sess = s.Session.Copy(config)
+	}
+
+	s.accountIDMutex.Lock()
+	defer s.accountIDMutex.Unlock()
+
+	return &Session{
+		Session:              sess,
+		Config:               config,
+		AccountID:            s.AccountID,
+		AccountAlias:         s.AccountAlias,
+		Sink:                 s.Sink,
+		RoleSessionName:      s.RoleSessionName,
+		Options:              s.Options,
+		accountAliasResolved: s.accountAliasResolved,
+	}
+}
+
+const DefaultRoleSessionName = "aws-dump"
+
+func (s *Session) WithAssumedRole(roleArn, externalID string) (*Session, error) {
+	if s.Session == nil {
+		return nil, errors.New("can't assume a role without an AWS session")
+	}
+	assumed := s.assumeRole(roleArn, externalID)
+	if _, err := assumed.ResolveAccountID(); err != nil {
+		return nil, errors.Wrapf(err, "failed to assume role %s", roleArn)
+	}
+	return assumed, nil
+}
+
+// Each link of the chain refreshes its own short lived credentials using the
+// previous link, so an expired intermediate role is assumed again on demand.
+func (s *Session) WithAssumedRoleChain(roleArns []string) (*Session, error) {
+	if len(roleArns) == 0 {
+		return nil, errors.New("empty role chain")
+	}
+	if s.Session == nil {
+		return nil, errors.New("can't assume a role without an AWS session")
+	}
+
+	assumed := s
+	for _, roleArn := range roleArns {
+		assumed = assumed.assumeRole(roleArn, "")
+	}
+
+	if _, err := assumed.ResolveAccountID(); err != nil {
+		return nil, errors.Wrapf(err, "failed to assume role chain %s", strings.Join(roleArns, " -> "))
+	}
+	return assumed, nil
+}
+
+func (s *Session) assumeRole(roleArn, externalID string) *Session {
+	sessionName := s.RoleSessionName
+	if sessionName == "" {
+		sessionName = DefaultRoleSessionName
+	}
+
+	creds := stscreds.NewCredentials(s.Session.Copy(s.Config), roleArn, func(p *stscreds.AssumeRoleProvider) {
+		p.RoleSessionName = sessionName
+		p.Duration = stscreds.DefaultDuration
+		if externalID != "" {
+			p.ExternalID = aws.String(externalID)
+		}
+	})
+
+	return &Session{
+		Session:         s.Session,
+		Config:          s.Config.Copy().WithCredentials(creds),
+		Sink:            s.Sink,
+		RoleSessionName: s.RoleSessionName,
+		Options:         s.Options,
+	}
+}
+
+func (s *Session) Emit(resources ...Resource) error {
+	if s.Sink == nil {
+		return nil
+	}
+
+	region := ""
+	if s.Config != nil {
+		region = aws.StringValue(s.Config.Region)
+	}
+	for _, resource := range resources {
+		// streamed resources don't go through the labelling of the report
+		// result, they get the same account and region labels here
+		s.labelAccount(&resource)
+		if resource.Region == "" {
+			resource.Region = region
+		}
+		err := s.Sink.Emit(resource)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func NewAccountsFromFile(filename string) ([]*Account, error) {
@@ -55,15 +213,12 @@ func OpenSessions(accounts []*Account) error {
 				MFATokenCode:    aws.String(""),
 			})
 
-			stsClient := sts.New(sess, conf)
-			identity, err := stsClient.GetCallerIdentity(&sts.GetCallerIdentityInput{})
-			if err != nil {
-				return err
-			}
 			session := &Session{
-				Session:   sess,
-				Config:    conf,
-				AccountID: *identity.Account,
+				Session: sess,
+				Config:  conf,
+			}
+			if _, err := session.ResolveAccountID(); err != nil {
+				return err
 			}
 			account.Sessions = append(account.Sessions, session)
 		}
@@ -0,0 +1,148 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/fatih/structs"
+)
+
+var (
+	BackupService = Service{
+		Name: "backup",
+		Reports: map[string]Report{
+			"plans":  BackupListPlans,
+			"vaults": BackupListVaults,
+		},
+	}
+)
+
+func init() {
+	RegisterService(BackupService)
+}
+
+func BackupListPlans(ctx context.Context, session *Session) *ReportResult {
+	client := session.BackupClient()
+
+	result := &ReportResult{}
+	err := client.ListBackupPlansPagesWithContext(ctx, &backup.ListBackupPlansInput{},
+		func(page *backup.ListBackupPlansOutput, lastPage bool) bool {
+			for _, listed := range page.BackupPlansList {
+				plan, err := client.GetBackupPlanWithContext(ctx, &backup.GetBackupPlanInput{BackupPlanId: listed.BackupPlanId})
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+
+				resource, err := NewResource(*plan.BackupPlanArn, plan)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "backup-plan"
+
+				rules := []map[string]interface{}{}
+				if plan.BackupPlan != nil {
+					for _, rule := range plan.BackupPlan.Rules {
+						summary := map[string]interface{}{
+							"RuleName":              aws.StringValue(rule.RuleName),
+							"ScheduleExpression":    aws.StringValue(rule.ScheduleExpression),
+							"TargetBackupVaultName": aws.StringValue(rule.TargetBackupVaultName),
+						}
+						if rule.Lifecycle != nil {
+							summary["Lifecycle"] = structs.Map(rule.Lifecycle)
+						}
+						rules = append(rules, summary)
+					}
+				}
+				resource.Metadata["Rules"] = rules
+
+				selections, err := backupListSelections(ctx, client, listed.BackupPlanId)
+				if err != nil {
+					result.AddError(err)
+					resource.Metadata["Error"] = err.Error()
+				}
+				resource.Metadata["Selections"] = selections
+
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
+
+func backupListSelections(ctx context.Context, client *backup.Backup, planID *string) ([]map[string]interface{}, error) {
+	selections := []map[string]interface{}{}
+	var getErr error
+	err := client.ListBackupSelectionsPagesWithContext(ctx, &backup.ListBackupSelectionsInput{BackupPlanId: planID},
+		func(page *backup.ListBackupSelectionsOutput, lastPage bool) bool {
+			for _, listed := range page.BackupSelectionsList {
+				selection, err := client.GetBackupSelectionWithContext(ctx, &backup.GetBackupSelectionInput{
+					BackupPlanId: planID,
+					SelectionId:  listed.SelectionId,
+				})
+				if err != nil {
+					getErr = err
+					return false
+				}
+				metadata := map[string]interface{}{"SelectionId": aws.StringValue(listed.SelectionId)}
+				if selection.BackupSelection != nil {
+					metadata = structs.Map(selection.BackupSelection)
+					metadata["SelectionId"] = aws.StringValue(listed.SelectionId)
+				}
+				selections = append(selections, metadata)
+			}
+			return true
+		})
+	if err != nil {
+		return selections, err
+	}
+	return selections, getErr
+}
+
+func BackupListVaults(ctx context.Context, session *Session) *ReportResult {
+	client := session.BackupClient()
+
+	result := &ReportResult{}
+	err := client.ListBackupVaultsPagesWithContext(ctx, &backup.ListBackupVaultsInput{},
+		func(page *backup.ListBackupVaultsOutput, lastPage bool) bool {
+			for _, vault := range page.BackupVaultList {
+				resource, err := NewResource(*vault.BackupVaultArn, vault)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "backup-vault"
+				resource.Metadata["EncryptionKeyArn"] = aws.StringValue(vault.EncryptionKeyArn)
+				resource.Metadata["NumberOfRecoveryPoints"] = aws.Int64Value(vault.NumberOfRecoveryPoints)
+				resource.Metadata["Locked"] = aws.BoolValue(vault.Locked)
+
+				resource.Metadata["AccessPolicy"] = nil
+				policy, err := client.GetBackupVaultAccessPolicyWithContext(ctx, &backup.GetBackupVaultAccessPolicyInput{
+					BackupVaultName: vault.BackupVaultName,
+				})
+				if err == nil {
+					if policy.Policy != nil {
+						document, err := DecodeInlinePolicyDocument(*policy.Policy)
+						if err != nil {
+							result.AddError(err)
+						} else {
+							resource.Metadata["AccessPolicy"] = document
+						}
+					}
+				} else if !IsAWSErrorCode(err, backup.ErrCodeResourceNotFoundException) {
+					result.AddError(err)
+					resource.Metadata["Error"] = err.Error()
+				}
+
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
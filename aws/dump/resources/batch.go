@@ -0,0 +1,138 @@
+package resources
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+)
+
+var (
+	BatchService = Service{
+		Name: "batch",
+		Reports: map[string]Report{
+			"compute-environments": BatchListComputeEnvironments,
+			"job-queues":           BatchListJobQueues,
+			"job-definitions":      BatchListJobDefinitions,
+		},
+	}
+)
+
+func init() {
+	RegisterService(BatchService)
+}
+
+func BatchListComputeEnvironments(ctx context.Context, session *Session) *ReportResult {
+	client := session.BatchClient()
+
+	result := &ReportResult{}
+	err := client.DescribeComputeEnvironmentsPagesWithContext(ctx, &batch.DescribeComputeEnvironmentsInput{},
+		func(page *batch.DescribeComputeEnvironmentsOutput, lastPage bool) bool {
+			for _, environment := range page.ComputeEnvironments {
+				resource, err := NewResource(*environment.ComputeEnvironmentArn, environment)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "compute-environment"
+				resource.Metadata["ServiceRole"] = aws.StringValue(environment.ServiceRole)
+				if computeResources := environment.ComputeResources; computeResources != nil {
+					resource.Metadata["ComputeType"] = aws.StringValue(computeResources.Type)
+					resource.Metadata["AllocationStrategy"] = aws.StringValue(computeResources.AllocationStrategy)
+					resource.Metadata["InstanceRole"] = aws.StringValue(computeResources.InstanceRole)
+				}
+				resource.Metadata["TagsMap"] = aws.StringValueMap(environment.Tags)
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
+
+func BatchListJobQueues(ctx context.Context, session *Session) *ReportResult {
+	client := session.BatchClient()
+
+	result := &ReportResult{}
+	err := client.DescribeJobQueuesPagesWithContext(ctx, &batch.DescribeJobQueuesInput{},
+		func(page *batch.DescribeJobQueuesOutput, lastPage bool) bool {
+			for _, queue := range page.JobQueues {
+				resource, err := NewResource(*queue.JobQueueArn, queue)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "job-queue"
+
+				environments := []string{}
+				for _, order := range queue.ComputeEnvironmentOrder {
+					environments = append(environments, aws.StringValue(order.ComputeEnvironment))
+				}
+				resource.Metadata["ComputeEnvironments"] = environments
+				resource.Metadata["TagsMap"] = aws.StringValueMap(queue.Tags)
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
+
+func BatchListJobDefinitions(ctx context.Context, session *Session) *ReportResult {
+	client := session.BatchClient()
+
+	result := &ReportResult{}
+	err := client.DescribeJobDefinitionsPagesWithContext(ctx, &batch.DescribeJobDefinitionsInput{Status: aws.String("ACTIVE")},
+		func(page *batch.DescribeJobDefinitionsOutput, lastPage bool) bool {
+			for _, definition := range page.JobDefinitions {
+				// Environment values can contain secrets, only keep the names.
+				var container map[string]interface{}
+				if properties := definition.ContainerProperties; properties != nil {
+					container = batchContainerSummary(properties)
+					properties.Environment = nil
+				}
+
+				resource, err := NewResource(*definition.JobDefinitionArn, definition)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "job-definition"
+				resource.Metadata["Container"] = container
+				resource.Metadata["TagsMap"] = aws.StringValueMap(definition.Tags)
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
+
+func batchContainerSummary(properties *batch.ContainerProperties) map[string]interface{} {
+	vcpus := aws.Int64Value(properties.Vcpus)
+	memory := aws.Int64Value(properties.Memory)
+	requirements := map[string]string{}
+	for _, requirement := range properties.ResourceRequirements {
+		requirements[aws.StringValue(requirement.Type)] = aws.StringValue(requirement.Value)
+	}
+
+	names := []string{}
+	for _, variable := range properties.Environment {
+		names = append(names, aws.StringValue(variable.Name))
+	}
+	sort.Strings(names)
+
+	return map[string]interface{}{
+		"Image":                    aws.StringValue(properties.Image),
+		"Vcpus":                    vcpus,
+		"Memory":                   memory,
+		"ResourceRequirements":     requirements,
+		"ExecutionRoleArn":         aws.StringValue(properties.ExecutionRoleArn),
+		"JobRoleArn":               aws.StringValue(properties.JobRoleArn),
+		"EnvironmentVariableNames": names,
+	}
+}
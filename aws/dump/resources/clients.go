@@ -0,0 +1,250 @@
+package resources
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+)
+
+// clientConfig returns the session config with the throttle retries of the
+// dump options. The metrics and logging handlers are on the session itself
+// while a report runs, so every client created from it gets them. Reports
+// create their clients with the factories below so retries are configured
+// the same way everywhere.
+func (s *Session) clientConfig() *aws.Config {
+	options := s.options()
+	config := &aws.Config{}
+	if s.Config != nil {
+		config = s.Config.Copy()
+	}
+	return request.WithRetryer(config, client.DefaultRetryer{
+		NumMaxRetries:    options.ThrottleMaxRetries,
+		MinThrottleDelay: options.ThrottleBaseDelay,
+		MaxThrottleDelay: options.ThrottleMaxDelay,
+	})
+}
+
+func (s *Session) ACMClient() *acm.ACM {
+	return acm.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) APIGatewayClient() *apigateway.APIGateway {
+	return apigateway.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) APIGatewayV2Client() *apigatewayv2.ApiGatewayV2 {
+	return apigatewayv2.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) AutoScalingClient() *autoscaling.AutoScaling {
+	return autoscaling.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) BackupClient() *backup.Backup {
+	return backup.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) BatchClient() *batch.Batch {
+	return batch.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) CloudFrontClient() *cloudfront.CloudFront {
+	return cloudfront.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) CloudTrailClient() *cloudtrail.CloudTrail {
+	return cloudtrail.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) CloudWatchClient() *cloudwatch.CloudWatch {
+	return cloudwatch.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) CloudWatchLogsClient() *cloudwatchlogs.CloudWatchLogs {
+	return cloudwatchlogs.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) CognitoIdentityProviderClient() *cognitoidentityprovider.CognitoIdentityProvider {
+	return cognitoidentityprovider.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) DynamoDBClient() *dynamodb.DynamoDB {
+	return dynamodb.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) EC2Client() *ec2.EC2 {
+	return ec2.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) ECRClient() *ecr.ECR {
+	return ecr.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) ECSClient() *ecs.ECS {
+	return ecs.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) EFSClient() *efs.EFS {
+	return efs.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) EKSClient() *eks.EKS {
+	return eks.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) ElastiCacheClient() *elasticache.ElastiCache {
+	return elasticache.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) ElasticBeanstalkClient() *elasticbeanstalk.ElasticBeanstalk {
+	return elasticbeanstalk.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) ELBClient() *elb.ELB {
+	return elb.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) ELBV2Client() *elbv2.ELBV2 {
+	return elbv2.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) FirehoseClient() *firehose.Firehose {
+	return firehose.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) FSxClient() *fsx.FSx {
+	return fsx.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) GlueClient() *glue.Glue {
+	return glue.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) GuardDutyClient() *guardduty.GuardDuty {
+	return guardduty.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) IAMClient() *iam.IAM {
+	return iam.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) Inspector2Client() *inspector2.Inspector2 {
+	return inspector2.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) KinesisClient() *kinesis.Kinesis {
+	return kinesis.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) KMSClient() *kms.KMS {
+	return kms.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) LambdaClient() *lambda.Lambda {
+	return lambda.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) Macie2Client() *macie2.Macie2 {
+	return macie2.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) OrganizationsClient() *organizations.Organizations {
+	return organizations.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) RDSClient() *rds.RDS {
+	return rds.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) RedshiftClient() *redshift.Redshift {
+	return redshift.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) Route53Client() *route53.Route53 {
+	return route53.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) S3Client() *s3.S3 {
+	return s3.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) SageMakerClient() *sagemaker.SageMaker {
+	return sagemaker.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) SecretsManagerClient() *secretsmanager.SecretsManager {
+	return secretsmanager.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) SFNClient() *sfn.SFN {
+	return sfn.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) SNSClient() *sns.SNS {
+	return sns.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) SQSClient() *sqs.SQS {
+	return sqs.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) SSMClient() *ssm.SSM {
+	return ssm.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) STSClient() *sts.STS {
+	return sts.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) TransferClient() *transfer.Transfer {
+	return transfer.New(s.Session, s.clientConfig())
+}
+
+func (s *Session) WAFV2Client() *wafv2.WAFV2 {
+	return wafv2.New(s.Session, s.clientConfig())
+}
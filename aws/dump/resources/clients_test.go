@@ -0,0 +1,30 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	awssession "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIAMClientAppliesDumpOptions(t *testing.T) {
+	t.Parallel()
+
+	config := &aws.Config{Region: aws.String("us-east-1"), Credentials: credentials.AnonymousCredentials}
+	sess, err := awssession.NewSession(config)
+	require.NoError(t, err)
+
+	options := DefaultDumpOptions()
+	options.ThrottleMaxRetries = 3
+	session := &Session{Session: sess, Config: config, AccountID: "123456789012", Options: options}
+
+	client := session.IAMClient()
+	require.Equal(t, 3, client.Config.Retryer.(interface{ MaxRetries() int }).MaxRetries())
+	// the session config is left untouched
+	require.Nil(t, session.Config.Retryer)
+
+	instrumented := session.withMetrics(&ReportMetrics{})
+	require.Equal(t, client.Handlers.Send.Len()+1, instrumented.IAMClient().Handlers.Send.Len())
+}
@@ -0,0 +1,84 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+)
+
+var (
+	CloudFrontService = Service{
+		Name:     "cloudfront",
+		IsGlobal: true,
+		Reports: map[string]Report{
+			"distributions": CloudFrontListDistributions,
+		},
+	}
+)
+
+func init() {
+	RegisterService(CloudFrontService)
+}
+
+func CloudFrontListDistributions(ctx context.Context, session *Session) *ReportResult {
+	client := session.CloudFrontClient()
+
+	result := &ReportResult{}
+	err := client.ListDistributionsPagesWithContext(ctx, &cloudfront.ListDistributionsInput{},
+		func(page *cloudfront.ListDistributionsOutput, lastPage bool) bool {
+			for _, distribution := range page.DistributionList.Items {
+				resource, err := NewResource(*distribution.ARN, distribution)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "distribution"
+
+				origins := []string{}
+				if distribution.Origins != nil {
+					for _, origin := range distribution.Origins.Items {
+						origins = append(origins, aws.StringValue(origin.DomainName))
+					}
+				}
+				resource.Metadata["OriginDomainNames"] = origins
+
+				viewerProtocolPolicy := ""
+				if distribution.DefaultCacheBehavior != nil {
+					viewerProtocolPolicy = aws.StringValue(distribution.DefaultCacheBehavior.ViewerProtocolPolicy)
+				}
+				resource.Metadata["ViewerProtocolPolicy"] = viewerProtocolPolicy
+
+				allowsHTTP := viewerProtocolPolicy == cloudfront.ViewerProtocolPolicyAllowAll
+				if distribution.CacheBehaviors != nil {
+					for _, behavior := range distribution.CacheBehaviors.Items {
+						if aws.StringValue(behavior.ViewerProtocolPolicy) == cloudfront.ViewerProtocolPolicyAllowAll {
+							allowsHTTP = true
+						}
+					}
+				}
+				resource.Metadata["AllowsHTTP"] = allowsHTTP
+
+				resource.Metadata["WebACLId"] = aws.StringValue(distribution.WebACLId)
+				if distribution.ViewerCertificate != nil {
+					resource.Metadata["MinimumProtocolVersion"] = aws.StringValue(distribution.ViewerCertificate.MinimumProtocolVersion)
+				}
+
+				config, err := client.GetDistributionConfigWithContext(ctx, &cloudfront.GetDistributionConfigInput{
+					Id: distribution.Id,
+				})
+				if err != nil {
+					result.AddError(err)
+					resource.Metadata["Error"] = err.Error()
+				} else if config.DistributionConfig.Logging != nil {
+					resource.Metadata["LoggingEnabled"] = aws.BoolValue(config.DistributionConfig.Logging.Enabled)
+				}
+
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
@@ -0,0 +1,63 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/fatih/structs"
+)
+
+var (
+	CloudTrailService = Service{
+		Name: "cloudtrail",
+		Reports: map[string]Report{
+			"trails": CloudTrailListTrails,
+		},
+	}
+)
+
+func init() {
+	RegisterService(CloudTrailService)
+}
+
+func CloudTrailListTrails(ctx context.Context, session *Session) *ReportResult {
+	client := session.CloudTrailClient()
+
+	// Shadow trails are the copies of multi-region trails in every other
+	// region, skipping them makes sure each trail is only reported once from
+	// its home region.
+	res, err := client.DescribeTrailsWithContext(ctx, &cloudtrail.DescribeTrailsInput{
+		IncludeShadowTrails: aws.Bool(false),
+	})
+	if err != nil {
+		return &ReportResult{Error: err}
+	}
+
+	result := &ReportResult{}
+	for _, trail := range res.TrailList {
+		if trail.HomeRegion != nil && *trail.HomeRegion != *session.Config.Region {
+			continue
+		}
+
+		resource, err := NewResource(*trail.TrailARN, trail)
+		if err != nil {
+			result.AddError(err)
+			continue
+		}
+		resource.ID = *trail.TrailARN
+		resource.Type = "trail"
+
+		status, err := client.GetTrailStatusWithContext(ctx, &cloudtrail.GetTrailStatusInput{Name: trail.TrailARN})
+		if err != nil {
+			result.AddError(err)
+		} else {
+			resource.Metadata["Status"] = structs.Map(status)
+			resource.Metadata["IsLogging"] = aws.BoolValue(status.IsLogging)
+		}
+
+		result.Resources = append(result.Resources, *resource)
+	}
+
+	return result
+}
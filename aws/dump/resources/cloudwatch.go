@@ -1,6 +1,10 @@
 package resources
 
-import "github.com/aws/aws-sdk-go/service/cloudwatch"
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+)
 
 var (
 	CloudwatchService = Service{
@@ -11,11 +15,15 @@ var (
 	}
 )
 
-func CloudwatchListAlarms(session *Session) *ReportResult {
-	client := cloudwatch.New(session.Session, session.Config)
+func init() {
+	RegisterService(CloudwatchService)
+}
+
+func CloudwatchListAlarms(ctx context.Context, session *Session) *ReportResult {
+	client := session.CloudWatchClient()
 
 	result := &ReportResult{}
-	result.Error = client.DescribeAlarmsPages(&cloudwatch.DescribeAlarmsInput{},
+	result.Error = client.DescribeAlarmsPagesWithContext(ctx, &cloudwatch.DescribeAlarmsInput{},
 		func(page *cloudwatch.DescribeAlarmsOutput, lastPage bool) bool {
 			for _, alarm := range page.MetricAlarms {
 
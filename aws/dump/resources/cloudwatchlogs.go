@@ -0,0 +1,66 @@
+package resources
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/fatih/structs"
+)
+
+var (
+	CloudWatchLogsService = Service{
+		Name: "logs",
+		Reports: map[string]Report{
+			"log-groups": LogsListGroups,
+		},
+	}
+)
+
+func init() {
+	RegisterService(CloudWatchLogsService)
+}
+
+func LogsListGroups(ctx context.Context, session *Session) *ReportResult {
+	client := session.CloudWatchLogsClient()
+
+	result := &ReportResult{}
+	err := client.DescribeLogGroupsPagesWithContext(ctx, &cloudwatchlogs.DescribeLogGroupsInput{},
+		func(page *cloudwatchlogs.DescribeLogGroupsOutput, lastPage bool) bool {
+			for _, logGroup := range page.LogGroups {
+				resource := Resource{
+					ID: *logGroup.LogGroupName,
+					// the log group ARN ends with :* which isn't part of the resource
+					ARN:       strings.TrimSuffix(aws.StringValue(logGroup.Arn), ":*"),
+					AccountID: session.AccountID,
+					Service:   "logs",
+					Type:      "log-group",
+					Region:    *session.Config.Region,
+					Metadata:  structs.Map(logGroup),
+				}
+				resource.Metadata["NoRetention"] = logGroup.RetentionInDays == nil
+
+				metricFilterCount := 0
+				err := client.DescribeMetricFiltersPagesWithContext(ctx, &cloudwatchlogs.DescribeMetricFiltersInput{
+					LogGroupName: logGroup.LogGroupName,
+				},
+					func(page *cloudwatchlogs.DescribeMetricFiltersOutput, lastPage bool) bool {
+						metricFilterCount += len(page.MetricFilters)
+						return true
+					})
+				if err != nil {
+					result.AddError(err)
+					resource.Metadata["Error"] = err.Error()
+				} else {
+					resource.Metadata["MetricFilterCount"] = metricFilterCount
+				}
+
+				result.Resources = append(result.Resources, resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
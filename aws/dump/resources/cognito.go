@@ -0,0 +1,113 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/fatih/structs"
+)
+
+var (
+	CognitoService = Service{
+		Name: "cognito-idp",
+		Reports: map[string]Report{
+			"user-pools": CognitoListUserPools,
+		},
+	}
+)
+
+func init() {
+	RegisterService(CognitoService)
+}
+
+func CognitoListUserPools(ctx context.Context, session *Session) *ReportResult {
+	client := session.CognitoIdentityProviderClient()
+
+	result := &ReportResult{}
+	err := client.ListUserPoolsPagesWithContext(ctx, &cognitoidentityprovider.ListUserPoolsInput{MaxResults: aws.Int64(60)},
+		func(page *cognitoidentityprovider.ListUserPoolsOutput, lastPage bool) bool {
+			for _, description := range page.UserPools {
+				described, err := client.DescribeUserPoolWithContext(ctx, &cognitoidentityprovider.DescribeUserPoolInput{
+					UserPoolId: description.Id,
+				})
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				userPool := described.UserPool
+
+				resource, err := NewResource(*userPool.Arn, userPool)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "user-pool"
+				resource.Metadata["MfaConfiguration"] = aws.StringValue(userPool.MfaConfiguration)
+				if userPool.Policies != nil && userPool.Policies.PasswordPolicy != nil {
+					resource.Metadata["PasswordPolicy"] = structs.Map(userPool.Policies.PasswordPolicy)
+				}
+				advancedSecurityMode := cognitoidentityprovider.AdvancedSecurityModeTypeOff
+				if userPool.UserPoolAddOns != nil {
+					advancedSecurityMode = aws.StringValue(userPool.UserPoolAddOns.AdvancedSecurityMode)
+				}
+				resource.Metadata["AdvancedSecurityMode"] = advancedSecurityMode
+				resource.Metadata["AdvancedSecurity"] = advancedSecurityMode != cognitoidentityprovider.AdvancedSecurityModeTypeOff
+
+				clients, err := cognitoListUserPoolClients(ctx, client, userPool.Id)
+				if err != nil {
+					result.AddError(err)
+					resource.Metadata["Error"] = err.Error()
+				}
+				resource.Metadata["Clients"] = clients
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
+
+func cognitoListUserPoolClients(ctx context.Context, client *cognitoidentityprovider.CognitoIdentityProvider, userPoolID *string) ([]map[string]interface{}, error) {
+	clients := []map[string]interface{}{}
+	var describeErr error
+	err := client.ListUserPoolClientsPagesWithContext(ctx, &cognitoidentityprovider.ListUserPoolClientsInput{UserPoolId: userPoolID},
+		func(page *cognitoidentityprovider.ListUserPoolClientsOutput, lastPage bool) bool {
+			for _, description := range page.UserPoolClients {
+				described, err := client.DescribeUserPoolClientWithContext(ctx, &cognitoidentityprovider.DescribeUserPoolClientInput{
+					UserPoolId: userPoolID,
+					ClientId:   description.ClientId,
+				})
+				if err != nil {
+					describeErr = err
+					return false
+				}
+				userPoolClient := described.UserPoolClient
+
+				// never keep the secret itself, only whether there is one
+				hasSecret := aws.StringValue(userPoolClient.ClientSecret) != ""
+				userPoolClient.ClientSecret = nil
+
+				implicit := false
+				for _, flow := range userPoolClient.AllowedOAuthFlows {
+					if aws.StringValue(flow) == cognitoidentityprovider.OAuthFlowTypeImplicit {
+						implicit = true
+					}
+				}
+
+				metadata := structs.Map(userPoolClient)
+				metadata["AllowedOAuthFlows"] = aws.StringValueSlice(userPoolClient.AllowedOAuthFlows)
+				metadata["HasClientSecret"] = hasSecret
+				metadata["AllowsImplicitGrant"] = implicit
+				// implicit grant clients run in browsers and can't keep a secret
+				metadata["SecretOnPublicClient"] = hasSecret && implicit
+				clients = append(clients, metadata)
+			}
+			return true
+		})
+	if err != nil {
+		return clients, err
+	}
+	return clients, describeErr
+}
@@ -0,0 +1,168 @@
+package resources
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+type Diff struct {
+	Added   []Resource
+	Removed []Resource
+	Changed []ResourceChange
+}
+
+type ResourceChange struct {
+	ARN    string
+	Old    Resource
+	New    Resource
+	Fields []string
+}
+
+type DiffOption func(*diffOptions)
+
+type diffOptions struct {
+	ignored map[string]bool
+}
+
+// DiffIgnoreFields skips metadata fields either by full path like
+// AccessKeyLastUsed.LastUsedDate or by name at any depth like LastUsed.
+func DiffIgnoreFields(fields ...string) DiffOption {
+	return func(o *diffOptions) {
+		for _, field := range fields {
+			o.ignored[field] = true
+		}
+	}
+}
+
+func DiffResults(old, new *ReportResult, options ...DiffOption) *Diff {
+	opts := &diffOptions{ignored: map[string]bool{}}
+	for _, option := range options {
+		option(opts)
+	}
+
+	oldResources := indexResources(old)
+	newResources := indexResources(new)
+
+	diff := &Diff{}
+	for _, key := range sortedKeys(newResources) {
+		newResource := newResources[key]
+		oldResource, ok := oldResources[key]
+		if !ok {
+			diff.Added = append(diff.Added, newResource)
+			continue
+		}
+
+		fields := []string{}
+		opts.compare("", comparableMetadata(oldResource), comparableMetadata(newResource), &fields)
+		if len(fields) > 0 {
+			sort.Strings(fields)
+			diff.Changed = append(diff.Changed, ResourceChange{
+				ARN:    key,
+				Old:    oldResource,
+				New:    newResource,
+				Fields: fields,
+			})
+		}
+	}
+
+	for _, key := range sortedKeys(oldResources) {
+		if _, ok := newResources[key]; !ok {
+			diff.Removed = append(diff.Removed, oldResources[key])
+		}
+	}
+
+	return diff
+}
+
+func indexResources(result *ReportResult) map[string]Resource {
+	indexed := map[string]Resource{}
+	if result == nil {
+		return indexed
+	}
+	for _, resource := range result.Resources {
+		indexed[resource.UniqueID()] = resource
+	}
+	return indexed
+}
+
+func sortedKeys(resources map[string]Resource) []string {
+	keys := make([]string, 0, len(resources))
+	for key := range resources {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Dumps loaded from disk only contain JSON types, round trip the live
+// metadata through JSON so both sides compare the same way.
+func comparableMetadata(resource Resource) interface{} {
+	encoded, err := json.Marshal(resource.Normalized().Metadata)
+	if err != nil {
+		return resource.Metadata
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return resource.Metadata
+	}
+	return decoded
+}
+
+func (o *diffOptions) isIgnored(path string) bool {
+	if o.ignored[path] {
+		return true
+	}
+	name := path
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	if i := strings.Index(name, "["); i >= 0 {
+		name = name[:i]
+	}
+	return o.ignored[name]
+}
+
+func (o *diffOptions) compare(path string, old, new interface{}, fields *[]string) {
+	if path != "" && o.isIgnored(path) {
+		return
+	}
+
+	oldMap, oldIsMap := old.(map[string]interface{})
+	newMap, newIsMap := new.(map[string]interface{})
+	if oldIsMap && newIsMap {
+		keys := map[string]bool{}
+		for key := range oldMap {
+			keys[key] = true
+		}
+		for key := range newMap {
+			keys[key] = true
+		}
+		for key := range keys {
+			o.compare(joinPath(path, key), oldMap[key], newMap[key], fields)
+		}
+		return
+	}
+
+	oldSlice, oldIsSlice := old.([]interface{})
+	newSlice, newIsSlice := new.([]interface{})
+	if oldIsSlice && newIsSlice && len(oldSlice) == len(newSlice) {
+		for i := range oldSlice {
+			o.compare(fmt.Sprintf("%s[%d]", path, i), oldSlice[i], newSlice[i], fields)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(old, new) {
+		*fields = append(*fields, path)
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return fmt.Sprintf("%s.%s", path, key)
+}
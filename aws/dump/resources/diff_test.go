@@ -0,0 +1,44 @@
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffResults(t *testing.T) {
+	t.Parallel()
+
+	yesterday := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	today := yesterday.Add(24 * time.Hour)
+
+	old := &ReportResult{Resources: []Resource{
+		{ARN: "arn:aws:s3:::removed"},
+		{ARN: "arn:aws:s3:::unchanged", Metadata: map[string]interface{}{"LastUsed": yesterday}},
+		{ARN: "arn:aws:s3:::changed", Metadata: map[string]interface{}{
+			"Versioning": "Enabled",
+			"Tags":       []interface{}{map[string]interface{}{"Key": "env", "Value": "dev"}},
+		}},
+	}}
+	new := &ReportResult{Resources: []Resource{
+		{ARN: "arn:aws:s3:::added"},
+		{ARN: "arn:aws:s3:::unchanged", Metadata: map[string]interface{}{"LastUsed": &today}},
+		{ARN: "arn:aws:s3:::changed", Metadata: map[string]interface{}{
+			"Versioning": "Suspended",
+			"Tags":       []map[string]string{{"Key": "env", "Value": "prod"}},
+		}},
+	}}
+
+	diff := DiffResults(old, new, DiffIgnoreFields("LastUsed"))
+
+	require.Len(t, diff.Added, 1)
+	require.Equal(t, "arn:aws:s3:::added", diff.Added[0].ARN)
+	require.Len(t, diff.Removed, 1)
+	require.Equal(t, "arn:aws:s3:::removed", diff.Removed[0].ARN)
+	require.Len(t, diff.Changed, 1)
+	require.Equal(t, "arn:aws:s3:::changed", diff.Changed[0].ARN)
+	require.Equal(t, []string{"Tags[0].Value", "Versioning"}, diff.Changed[0].Fields)
+
+	require.Len(t, DiffResults(old, new).Changed, 2)
+}
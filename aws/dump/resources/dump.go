@@ -0,0 +1,109 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// dedupSink forwards resources to the underlying sink once, so resources a
+// report both streamed and returned are only written one time.
+type dedupSink struct {
+	mutex   sync.Mutex
+	sink    ResourceSink
+	emitted map[string]bool
+}
+
+func (s *dedupSink) Emit(resource Resource) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	id := resource.UniqueID()
+	if s.emitted[id] {
+		return nil
+	}
+	s.emitted[id] = true
+	return s.sink.Emit(resource)
+}
+
+type dumpJob struct {
+	name    string
+	report  Report
+	session *Session
+}
+
+// DumpAll runs every registered report, across all the regions for regional
+// services, and writes the resources to sink, which must be safe for
+// concurrent use. Failing reports don't stop the dump, their errors are
+// returned together as a MultiError.
+func DumpAll(session *Session, regions []string, sink ResourceSink, concurrency int) error {
+	if _, err := session.ResolveAccountID(); err != nil {
+		return err
+	}
+	session.ResolveAccountAlias()
+	if len(regions) == 0 {
+		regions = []string{*session.Config.Region}
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	jobs := []dumpJob{}
+	for _, service := range AllServices() {
+		names := make([]string, 0, len(service.Reports))
+		for name := range service.Reports {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			reportName := fmt.Sprintf("%s:%s", service.Name, name)
+			if service.IsGlobal {
+				jobs = append(jobs, dumpJob{name: reportName, report: service.Reports[name], session: session.ForRegion(regions[0])})
+				continue
+			}
+			for _, region := range regions {
+				jobs = append(jobs, dumpJob{name: reportName, report: service.Reports[name], session: session.ForRegion(region)})
+			}
+		}
+	}
+
+	errors := &MultiError{}
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, concurrency)
+	for _, job := range jobs {
+		wg.Add(1)
+		go func(job dumpJob) {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			sink := &dedupSink{sink: sink, emitted: map[string]bool{}}
+			job.session.Sink = sink
+			region := *job.session.Config.Region
+
+			result := runReport(context.Background(), job.session, job.name, job.report)
+			for _, resource := range result.Resources {
+				if resource.Region == "" {
+					resource.Region = region
+				}
+				if err := sink.Emit(resource); err != nil {
+					result.AddError(err)
+					break
+				}
+			}
+
+			if result.Error != nil {
+				mutex.Lock()
+				errors.Append(fmt.Errorf("%s %s: %w", job.name, region, result.Error))
+				mutex.Unlock()
+			}
+		}(job)
+	}
+	wg.Wait()
+
+	return errors.ErrorOrNil()
+}
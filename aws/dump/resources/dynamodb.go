@@ -0,0 +1,69 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/fatih/structs"
+)
+
+var (
+	DynamoDBService = Service{
+		Name: "dynamodb",
+		Reports: map[string]Report{
+			"tables": DynamoDBListTables,
+		},
+	}
+)
+
+func init() {
+	RegisterService(DynamoDBService)
+}
+
+func DynamoDBListTables(ctx context.Context, session *Session) *ReportResult {
+	client := session.DynamoDBClient()
+
+	result := &ReportResult{}
+	err := client.ListTablesPagesWithContext(ctx, &dynamodb.ListTablesInput{},
+		func(page *dynamodb.ListTablesOutput, lastPage bool) bool {
+			for _, tableName := range page.TableNames {
+				table, err := client.DescribeTableWithContext(ctx, &dynamodb.DescribeTableInput{TableName: tableName})
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+
+				resource, err := NewResource(*table.Table.TableArn, table.Table)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "table"
+				resource.Region = *session.Config.Region
+
+				ttl, err := client.DescribeTimeToLiveWithContext(ctx, &dynamodb.DescribeTimeToLiveInput{TableName: tableName})
+				if err != nil {
+					result.AddError(err)
+				} else {
+					resource.Metadata["TimeToLive"] = structs.Map(ttl.TimeToLiveDescription)
+				}
+
+				backups, err := client.DescribeContinuousBackupsWithContext(ctx, &dynamodb.DescribeContinuousBackupsInput{TableName: tableName})
+				if err != nil {
+					result.AddError(err)
+				} else {
+					resource.Metadata["ContinuousBackups"] = structs.Map(backups.ContinuousBackupsDescription)
+					pitr := backups.ContinuousBackupsDescription.PointInTimeRecoveryDescription
+					resource.Metadata["PointInTimeRecoveryEnabled"] = pitr != nil &&
+						*pitr.PointInTimeRecoveryStatus == dynamodb.PointInTimeRecoveryStatusEnabled
+				}
+
+				result.Resources = append(result.Resources, *resource)
+			}
+
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
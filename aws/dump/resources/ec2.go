@@ -1,10 +1,13 @@
 package resources
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/fatih/structs"
 )
@@ -13,58 +16,238 @@ var (
 	EC2Service = Service{
 		Name: "ec2",
 		Reports: map[string]Report{
-			"vpcs":             EC2ListVpcs,
-			"security-groups":  EC2ListSecurityGroups,
-			"images":           EC2ListImages,
-			"instances":        EC2ListInstances,
-			"launch-templates": EC2ListLaunchTemplates,
-			"nat-gateways":     EC2ListNATGateways,
-			"key-pairs":        EC2ListKeyPairs,
+			"vpcs":               EC2ListVpcs,
+			"subnets":            EC2ListSubnets,
+			"route-tables":       EC2ListRouteTables,
+			"security-groups":    EC2ListSecurityGroups,
+			"images":             EC2ListImages,
+			"instances":          EC2ListInstances,
+			"launch-templates":   EC2ListLaunchTemplates,
+			"nat-gateways":       EC2ListNATGateways,
+			"internet-gateways":  EC2ListInternetGateways,
+			"key-pairs":          EC2ListKeyPairs,
+			"volumes":            EC2ListVolumes,
+			"snapshots":          EC2ListSnapshots,
+			"elastic-ips":        EC2ListAddresses,
+			"network-interfaces": EC2ListNetworkInterfaces,
 		},
 	}
 )
 
-func EC2ListVpcs(session *Session) *ReportResult {
-	client := ec2.New(session.Session, session.Config)
+func init() {
+	RegisterService(EC2Service)
+}
 
-	vpcs := []Resource{}
+func EC2ListVpcs(ctx context.Context, session *Session) *ReportResult {
+	client := session.EC2Client()
 
-	res, err := client.DescribeVpcs(&ec2.DescribeVpcsInput{})
-	if err != nil {
-		return &ReportResult{nil, err}
-	}
+	result := &ReportResult{}
+	dhcpOptions, err := ec2DhcpOptions(ctx, client)
+	result.AddError(err)
+
+	err = client.DescribeVpcsPagesWithContext(ctx, &ec2.DescribeVpcsInput{},
+		func(page *ec2.DescribeVpcsOutput, lastPage bool) bool {
+			for _, vpc := range page.Vpcs {
+				arn := BuildARN(session.Partition(), "ec2",
+					*session.Config.Region,
+					*vpc.OwnerId,
+					fmt.Sprintf("vpc/%s", *vpc.VpcId),
+				)
+				resource, err := NewResource(arn, vpc)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "vpc"
 
-	for _, vpc := range res.Vpcs {
-		if *vpc.IsDefault {
-			continue
-		}
-		vpcs = append(vpcs, Resource{
-			ID: *vpc.VpcId,
-			// ARN
-			Service:   "ec2",
-			Type:      "vpc",
-			AccountID: *vpc.OwnerId,
-			Region:    *session.Config.Region,
-			Metadata:  structs.Map(vpc),
+				cidrBlocks := []string{}
+				for _, association := range vpc.CidrBlockAssociationSet {
+					cidrBlocks = append(cidrBlocks, aws.StringValue(association.CidrBlock))
+				}
+				for _, association := range vpc.Ipv6CidrBlockAssociationSet {
+					cidrBlocks = append(cidrBlocks, aws.StringValue(association.Ipv6CidrBlock))
+				}
+				resource.Metadata["CidrBlocks"] = cidrBlocks
+				resource.Metadata["DhcpOptions"] = dhcpOptions[aws.StringValue(vpc.DhcpOptionsId)]
+				resource.Metadata["TagsMap"] = EC2TagsToMap(vpc.Tags)
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
 		})
+
+	result.AddError(err)
+	return result
+}
+
+func ec2DhcpOptions(ctx context.Context, client *ec2.EC2) (map[string]map[string][]string, error) {
+	options := map[string]map[string][]string{}
+	err := client.DescribeDhcpOptionsPagesWithContext(ctx, &ec2.DescribeDhcpOptionsInput{},
+		func(page *ec2.DescribeDhcpOptionsOutput, lastPage bool) bool {
+			for _, dhcpOptions := range page.DhcpOptions {
+				configurations := map[string][]string{}
+				for _, configuration := range dhcpOptions.DhcpConfigurations {
+					values := []string{}
+					for _, value := range configuration.Values {
+						values = append(values, aws.StringValue(value.Value))
+					}
+					configurations[aws.StringValue(configuration.Key)] = values
+				}
+				options[*dhcpOptions.DhcpOptionsId] = configurations
+			}
+			return true
+		})
+	return options, err
+}
+
+func EC2ListSubnets(ctx context.Context, session *Session) *ReportResult {
+	client := session.EC2Client()
+
+	result := &ReportResult{}
+	err := client.DescribeSubnetsPagesWithContext(ctx, &ec2.DescribeSubnetsInput{},
+		func(page *ec2.DescribeSubnetsOutput, lastPage bool) bool {
+			for _, subnet := range page.Subnets {
+				resource, err := NewResource(*subnet.SubnetArn, subnet)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "subnet"
+				resource.Metadata["VpcId"] = *subnet.VpcId
+				resource.Metadata["TagsMap"] = EC2TagsToMap(subnet.Tags)
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
+
+func EC2ListRouteTables(ctx context.Context, session *Session) *ReportResult {
+	client := session.EC2Client()
+
+	result := &ReportResult{}
+	err := client.DescribeRouteTablesPagesWithContext(ctx, &ec2.DescribeRouteTablesInput{},
+		func(page *ec2.DescribeRouteTablesOutput, lastPage bool) bool {
+			for _, routeTable := range page.RouteTables {
+				arn := BuildARN(session.Partition(), "ec2",
+					*session.Config.Region,
+					*routeTable.OwnerId,
+					fmt.Sprintf("route-table/%s", *routeTable.RouteTableId),
+				)
+				resource, err := NewResource(arn, routeTable)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "route-table"
+				resource.Metadata["VpcId"] = aws.StringValue(routeTable.VpcId)
+
+				hasInternetGateway := false
+				routes := []map[string]interface{}{}
+				for _, route := range routeTable.Routes {
+					destination := aws.StringValue(route.DestinationCidrBlock)
+					if destination == "" {
+						destination = aws.StringValue(route.DestinationIpv6CidrBlock)
+					}
+					if destination == "" {
+						destination = aws.StringValue(route.DestinationPrefixListId)
+					}
+
+					internetGateway := strings.HasPrefix(aws.StringValue(route.GatewayId), "igw-")
+					if internetGateway {
+						hasInternetGateway = true
+					}
+
+					routes = append(routes, map[string]interface{}{
+						"Destination":     destination,
+						"Target":          ec2RouteTarget(route),
+						"State":           aws.StringValue(route.State),
+						"InternetGateway": internetGateway,
+					})
+				}
+				resource.Metadata["Routes"] = routes
+				resource.Metadata["HasInternetGateway"] = hasInternetGateway
+
+				subnets := []string{}
+				for _, association := range routeTable.Associations {
+					if association.SubnetId != nil {
+						subnets = append(subnets, *association.SubnetId)
+					}
+				}
+				resource.Metadata["SubnetIds"] = subnets
+				resource.Metadata["TagsMap"] = EC2TagsToMap(routeTable.Tags)
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
+
+func ec2RouteTarget(route *ec2.Route) string {
+	for _, target := range []*string{
+		route.GatewayId,
+		route.NatGatewayId,
+		route.TransitGatewayId,
+		route.VpcPeeringConnectionId,
+		route.NetworkInterfaceId,
+		route.InstanceId,
+		route.EgressOnlyInternetGatewayId,
+		route.CarrierGatewayId,
+		route.LocalGatewayId,
+	} {
+		if target != nil {
+			return *target
+		}
 	}
+	return ""
+}
+
+func EC2NormalizeIpPermissions(permissions []*ec2.IpPermission) []map[string]interface{} {
+	rules := []map[string]interface{}{}
+	for _, permission := range permissions {
+		rule := func(key, value string) map[string]interface{} {
+			r := map[string]interface{}{
+				"Protocol": aws.StringValue(permission.IpProtocol),
+				"FromPort": permission.FromPort,
+				"ToPort":   permission.ToPort,
+				"Cidr":     "",
+			}
+			r[key] = value
+			return r
+		}
 
-	return &ReportResult{vpcs, err}
+		for _, ipRange := range permission.IpRanges {
+			rules = append(rules, rule("Cidr", aws.StringValue(ipRange.CidrIp)))
+		}
+		for _, ipv6Range := range permission.Ipv6Ranges {
+			rules = append(rules, rule("Cidr", aws.StringValue(ipv6Range.CidrIpv6)))
+		}
+		for _, pair := range permission.UserIdGroupPairs {
+			rules = append(rules, rule("GroupId", aws.StringValue(pair.GroupId)))
+		}
+		for _, prefixList := range permission.PrefixListIds {
+			rules = append(rules, rule("PrefixListId", aws.StringValue(prefixList.PrefixListId)))
+		}
+	}
+	return rules
 }
 
-func EC2ListSecurityGroups(session *Session) *ReportResult {
-	client := ec2.New(session.Session, session.Config)
+func EC2ListSecurityGroups(ctx context.Context, session *Session) *ReportResult {
+	client := session.EC2Client()
 	result := &ReportResult{}
 	groupIds := []*string{}
-	err := client.DescribeSecurityGroupsPages(&ec2.DescribeSecurityGroupsInput{},
+	err := client.DescribeSecurityGroupsPagesWithContext(ctx, &ec2.DescribeSecurityGroupsInput{},
 		func(page *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
 			for _, securityGroup := range page.SecurityGroups {
 				resource := Resource{
 					ID: *securityGroup.GroupId,
-					ARN: fmt.Sprintf("arn:aws:ec2:%s:%s:security-group/%s",
+					ARN: BuildARN(session.Partition(), "ec2",
 						*session.Config.Region,
 						*securityGroup.OwnerId,
-						*securityGroup.GroupId,
+						fmt.Sprintf("security-group/%s", *securityGroup.GroupId),
 					),
 					Service:   "ec2",
 					Type:      "security-group",
@@ -75,6 +258,8 @@ func EC2ListSecurityGroups(session *Session) *ReportResult {
 				if securityGroup.VpcId != nil {
 					resource.Metadata["VpcId"] = *securityGroup.VpcId
 				}
+				resource.Metadata["IngressRules"] = EC2NormalizeIpPermissions(securityGroup.IpPermissions)
+				resource.Metadata["EgressRules"] = EC2NormalizeIpPermissions(securityGroup.IpPermissionsEgress)
 				groupIds = append(groupIds, securityGroup.GroupId)
 				result.Resources = append(result.Resources, resource)
 			}
@@ -98,7 +283,7 @@ func EC2ListSecurityGroups(session *Session) *ReportResult {
 
 	used := map[string]interface{}{}
 	for _, batch := range batches {
-		err := client.DescribeNetworkInterfacesPages(&ec2.DescribeNetworkInterfacesInput{
+		err := client.DescribeNetworkInterfacesPagesWithContext(ctx, &ec2.DescribeNetworkInterfacesInput{
 			Filters: []*ec2.Filter{
 				&ec2.Filter{
 					Name:   aws.String("group-id"),
@@ -132,136 +317,303 @@ func EC2ListSecurityGroups(session *Session) *ReportResult {
 	return result
 }
 
-func EC2ListImages(session *Session) *ReportResult {
-	client := ec2.New(session.Session, session.Config)
-
-	images := []Resource{}
+func EC2ListImages(ctx context.Context, session *Session) *ReportResult {
+	client := session.EC2Client()
 
-	res, err := client.DescribeImages(&ec2.DescribeImagesInput{
+	result := &ReportResult{}
+	err := client.DescribeImagesPagesWithContext(ctx, &ec2.DescribeImagesInput{
 		Owners: []*string{aws.String("self")},
-	})
-	if err != nil {
-		return &ReportResult{nil, err}
-	}
+	},
+		func(page *ec2.DescribeImagesOutput, lastPage bool) bool {
+			for _, image := range page.Images {
+				resource := Resource{
+					ID: *image.ImageId,
+					ARN: BuildARN(session.Partition(), "ec2",
+						*session.Config.Region,
+						*image.OwnerId,
+						fmt.Sprintf("image/%s", *image.ImageId),
+					),
+					Service:   "ec2",
+					Type:      "image",
+					AccountID: *image.OwnerId,
+					Region:    *session.Config.Region,
+					Metadata:  structs.Map(image),
+				}
+				resource.Metadata["TagsMap"] = EC2TagsToMap(image.Tags)
+
+				attribute, err := client.DescribeImageAttributeWithContext(ctx, &ec2.DescribeImageAttributeInput{
+					ImageId:   image.ImageId,
+					Attribute: aws.String(ec2.ImageAttributeNameLaunchPermission),
+				})
+				if err != nil {
+					result.AddError(err)
+					resource.Metadata["Error"] = err.Error()
+				} else {
+					public := false
+					accounts := []string{}
+					organizations := []string{}
+					for _, permission := range attribute.LaunchPermissions {
+						if aws.StringValue(permission.Group) == ec2.PermissionGroupAll {
+							public = true
+						}
+						if permission.UserId != nil {
+							accounts = append(accounts, *permission.UserId)
+						}
+						if permission.OrganizationArn != nil {
+							organizations = append(organizations, *permission.OrganizationArn)
+						}
+						if permission.OrganizationalUnitArn != nil {
+							organizations = append(organizations, *permission.OrganizationalUnitArn)
+						}
+					}
+					resource.Metadata["PubliclyShared"] = public
+					resource.Metadata["SharedWithAccounts"] = accounts
+					resource.Metadata["SharedWithOrganizations"] = organizations
+				}
 
-	for _, image := range res.Images {
-		images = append(images, Resource{
-			ID:        *image.ImageId,
-			Service:   "ec2",
-			Type:      "image",
-			AccountID: *image.OwnerId,
-			Region:    *session.Config.Region,
-			Metadata:  structs.Map(image),
+				result.Resources = append(result.Resources, resource)
+			}
+			return true
 		})
-	}
 
-	return &ReportResult{images, err}
+	result.AddError(err)
+	return result
 }
 
-func EC2ListInstances(session *Session) *ReportResult {
-	client := ec2.New(session.Session, session.Config)
+func EC2ListInstances(ctx context.Context, session *Session) *ReportResult {
+	client := session.EC2Client()
 
-	instances := []Resource{}
-	err := client.DescribeInstancesPages(&ec2.DescribeInstancesInput{},
+	result := &ReportResult{}
+	err := client.DescribeInstancesPagesWithContext(ctx, &ec2.DescribeInstancesInput{},
 		func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
 			for _, reservation := range page.Reservations {
 				for _, instance := range reservation.Instances {
-					resource := Resource{
-						ID:        *instance.InstanceId,
-						ARN:       "",
-						AccountID: session.AccountID,
-						Service:   "ec2",
-						Type:      "instance",
-						Region:    *session.Config.Region,
-						Metadata:  structs.Map(instance),
+					if instance.State != nil && *instance.State.Name == ec2.InstanceStateNameTerminated {
+						continue
 					}
-					instances = append(instances, resource)
+
+					arn := BuildARN(session.Partition(), "ec2",
+						*session.Config.Region,
+						session.AccountID,
+						fmt.Sprintf("instance/%s", *instance.InstanceId),
+					)
+					resource, err := NewResource(arn, instance)
+					if err != nil {
+						result.AddError(err)
+						continue
+					}
+					resource.Type = "instance"
+					resource.Region = *session.Config.Region
+					resource.Metadata["TagsMap"] = EC2TagsToMap(instance.Tags)
+					result.Resources = append(result.Resources, *resource)
 				}
 			}
 
 			return true
 		})
 
-	return &ReportResult{instances, err}
+	result.AddError(err)
+	return result
 }
 
-func EC2ListNATGateways(session *Session) *ReportResult {
+func EC2TagsToMap(tags []*ec2.Tag) map[string]string {
+	tagsMap := map[string]string{}
+	for _, tag := range tags {
+		if tag.Key == nil {
+			continue
+		}
+		tagsMap[*tag.Key] = aws.StringValue(tag.Value)
+	}
+	return tagsMap
+}
 
-	client := ec2.New(session.Session, session.Config)
+// NAT gateways without any outbound traffic over this window are flagged idle.
+const natGatewayIdleWindow = 7 * 24 * time.Hour
 
-	resources := []Resource{}
-	err := client.DescribeNatGatewaysPages(&ec2.DescribeNatGatewaysInput{},
+func EC2ListNATGateways(ctx context.Context, session *Session) *ReportResult {
+
+	client := session.EC2Client()
+	metrics := session.CloudWatchClient()
+
+	result := &ReportResult{}
+	err := client.DescribeNatGatewaysPagesWithContext(ctx, &ec2.DescribeNatGatewaysInput{},
 		func(page *ec2.DescribeNatGatewaysOutput, lastPage bool) bool {
 			for _, natGateway := range page.NatGateways {
 				resource := Resource{
-					ID:        *natGateway.NatGatewayId,
-					ARN:       "",
+					ID: *natGateway.NatGatewayId,
+					ARN: BuildARN(session.Partition(), "ec2",
+						*session.Config.Region,
+						session.AccountID,
+						fmt.Sprintf("natgateway/%s", *natGateway.NatGatewayId),
+					),
 					AccountID: session.AccountID,
 					Service:   "ec2",
 					Type:      "nat-gateway",
 					Region:    *session.Config.Region,
 					Metadata:  structs.Map(natGateway),
 				}
-				resources = append(resources, resource)
+				resource.Metadata["VpcId"] = aws.StringValue(natGateway.VpcId)
+				resource.Metadata["TagsMap"] = EC2TagsToMap(natGateway.Tags)
+
+				publicIPs := []string{}
+				for _, address := range natGateway.NatGatewayAddresses {
+					if address.PublicIp != nil {
+						publicIPs = append(publicIPs, *address.PublicIp)
+					}
+				}
+				resource.Metadata["PublicIPs"] = publicIPs
+
+				if aws.StringValue(natGateway.State) == ec2.NatGatewayStateAvailable {
+					idle, err := ec2NATGatewayIdle(ctx, metrics, *natGateway.NatGatewayId)
+					if err != nil {
+						result.AddError(err)
+						resource.Metadata["Error"] = err.Error()
+					} else {
+						resource.Metadata["Idle"] = idle
+					}
+				}
+
+				result.Resources = append(result.Resources, resource)
 			}
 
 			return true
 		})
 
-	return &ReportResult{resources, err}
+	result.AddError(err)
+	return result
+}
+
+func ec2NATGatewayIdle(ctx context.Context, client *cloudwatch.CloudWatch, natGatewayID string) (bool, error) {
+	now := time.Now()
+	statistics, err := client.GetMetricStatisticsWithContext(ctx, &cloudwatch.GetMetricStatisticsInput{
+		Namespace:  aws.String("AWS/NATGateway"),
+		MetricName: aws.String("BytesOutToDestination"),
+		Dimensions: []*cloudwatch.Dimension{
+			{Name: aws.String("NatGatewayId"), Value: aws.String(natGatewayID)},
+		},
+		StartTime:  aws.Time(now.Add(-natGatewayIdleWindow)),
+		EndTime:    aws.Time(now),
+		Period:     aws.Int64(int64(natGatewayIdleWindow / time.Second)),
+		Statistics: aws.StringSlice([]string{cloudwatch.StatisticSum}),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	for _, datapoint := range statistics.Datapoints {
+		if aws.Float64Value(datapoint.Sum) > 0 {
+			return false, nil
+		}
+	}
+	return true, nil
 }
 
-func EC2ListKeyPairs(session *Session) *ReportResult {
-	client := ec2.New(session.Session, session.Config)
+func EC2ListInternetGateways(ctx context.Context, session *Session) *ReportResult {
+	client := session.EC2Client()
+
+	result := &ReportResult{}
+	err := client.DescribeInternetGatewaysPagesWithContext(ctx, &ec2.DescribeInternetGatewaysInput{},
+		func(page *ec2.DescribeInternetGatewaysOutput, lastPage bool) bool {
+			for _, internetGateway := range page.InternetGateways {
+				resource, err := NewResource(BuildARN(session.Partition(), "ec2",
+					*session.Config.Region,
+					aws.StringValue(internetGateway.OwnerId),
+					fmt.Sprintf("internet-gateway/%s", *internetGateway.InternetGatewayId),
+				), internetGateway)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "internet-gateway"
+
+				vpcIds := []string{}
+				for _, attachment := range internetGateway.Attachments {
+					vpcIds = append(vpcIds, aws.StringValue(attachment.VpcId))
+				}
+				// an internet gateway is attached to at most one VPC
+				resource.Metadata["VpcId"] = ""
+				if len(vpcIds) > 0 {
+					resource.Metadata["VpcId"] = vpcIds[0]
+				}
+				resource.Metadata["TagsMap"] = EC2TagsToMap(internetGateway.Tags)
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
+
+func EC2ListKeyPairs(ctx context.Context, session *Session) *ReportResult {
+	client := session.EC2Client()
 
 	keypairs := []Resource{}
 
-	res, err := client.DescribeKeyPairs(&ec2.DescribeKeyPairsInput{})
+	res, err := client.DescribeKeyPairsWithContext(ctx, &ec2.DescribeKeyPairsInput{})
 	if err != nil {
-		return &ReportResult{nil, err}
+		return &ReportResult{Error: err}
 	}
 
 	for _, keypair := range res.KeyPairs {
-		keypairs = append(keypairs, Resource{
-			ID:        *keypair.KeyName,
+		resource := Resource{
+			ID: *keypair.KeyName,
+			ARN: BuildARN(session.Partition(), "ec2",
+				*session.Config.Region,
+				session.AccountID,
+				fmt.Sprintf("key-pair/%s", *keypair.KeyName),
+			),
 			Service:   "ec2",
 			Type:      "key-pair",
 			AccountID: session.AccountID,
 			Region:    *session.Config.Region,
 			Metadata:  structs.Map(keypair),
-		})
+		}
+		resource.Metadata["TagsMap"] = EC2TagsToMap(keypair.Tags)
+		keypairs = append(keypairs, resource)
 	}
 
-	return &ReportResult{keypairs, err}
+	return &ReportResult{Resources: keypairs, Error: err}
 }
 
-func EC2ListLaunchTemplates(session *Session) *ReportResult {
+func EC2ListLaunchTemplates(ctx context.Context, session *Session) *ReportResult {
 
-	client := ec2.New(session.Session, session.Config)
+	client := session.EC2Client()
 
 	resources := []Resource{}
 	result := &ReportResult{
 		Resources: resources,
 	}
-	err := client.DescribeLaunchTemplatesPages(&ec2.DescribeLaunchTemplatesInput{},
+	err := client.DescribeLaunchTemplatesPagesWithContext(ctx, &ec2.DescribeLaunchTemplatesInput{},
 		func(page *ec2.DescribeLaunchTemplatesOutput, lastPage bool) bool {
 			for _, launchTemplate := range page.LaunchTemplates {
 				resource := Resource{
-					ID:        *launchTemplate.LaunchTemplateId,
-					ARN:       "",
+					ID: *launchTemplate.LaunchTemplateId,
+					ARN: BuildARN(session.Partition(), "ec2",
+						*session.Config.Region,
+						session.AccountID,
+						fmt.Sprintf("launch-template/%s", *launchTemplate.LaunchTemplateId),
+					),
 					AccountID: session.AccountID,
 					Service:   "ec2",
 					Type:      "launch-template",
 					Region:    *session.Config.Region,
 					Metadata:  structs.Map(launchTemplate),
 				}
-				result.Resources = append(result.Resources, resource)
 
-				launchTemplateVersions := EC2ListLaunchTemplateVersions(session, *launchTemplate.LaunchTemplateId)
+				launchTemplateVersions := EC2ListLaunchTemplateVersions(ctx, session, *launchTemplate.LaunchTemplateId)
 				if launchTemplateVersions.Error != nil {
 					result.Error = launchTemplateVersions.Error
 					return false
 				}
+				for _, version := range launchTemplateVersions.Resources {
+					if version.Metadata["DefaultVersion"] == true {
+						for key, value := range version.Metadata["Summary"].(map[string]interface{}) {
+							resource.Metadata[key] = value
+						}
+					}
+				}
+				result.Resources = append(result.Resources, resource)
 				result.Resources = append(result.Resources, launchTemplateVersions.Resources...)
 			}
 
@@ -275,11 +627,11 @@ func EC2ListLaunchTemplates(session *Session) *ReportResult {
 	return result
 }
 
-func EC2ListLaunchTemplateVersions(session *Session, launchTemplateID string) *ReportResult {
-	client := ec2.New(session.Session, session.Config)
+func EC2ListLaunchTemplateVersions(ctx context.Context, session *Session, launchTemplateID string) *ReportResult {
+	client := session.EC2Client()
 
 	resources := []Resource{}
-	err := client.DescribeLaunchTemplateVersionsPages(&ec2.DescribeLaunchTemplateVersionsInput{LaunchTemplateId: aws.String(launchTemplateID)},
+	err := client.DescribeLaunchTemplateVersionsPagesWithContext(ctx, &ec2.DescribeLaunchTemplateVersionsInput{LaunchTemplateId: aws.String(launchTemplateID)},
 		func(page *ec2.DescribeLaunchTemplateVersionsOutput, lastPage bool) bool {
 			for _, launchTemplateVersion := range page.LaunchTemplateVersions {
 				resource := Resource{
@@ -291,10 +643,211 @@ func EC2ListLaunchTemplateVersions(session *Session, launchTemplateID string) *R
 					Region:    *session.Config.Region,
 					Metadata:  structs.Map(launchTemplateVersion),
 				}
+				resource.Metadata["DefaultVersion"] = aws.BoolValue(launchTemplateVersion.DefaultVersion)
+				if data := launchTemplateVersion.LaunchTemplateData; data != nil {
+					resource.Metadata["Summary"] = ec2LaunchTemplateDataSummary(data)
+					// only record whether user data is set, it often contains secrets
+					if data, ok := resource.Metadata["LaunchTemplateData"].(map[string]interface{}); ok {
+						delete(data, "UserData")
+					}
+				} else {
+					resource.Metadata["Summary"] = map[string]interface{}{}
+				}
 				resources = append(resources, resource)
 			}
 
 			return true
 		})
-	return &ReportResult{resources, err}
+	return &ReportResult{Resources: resources, Error: err}
+}
+
+func EC2ListVolumes(ctx context.Context, session *Session) *ReportResult {
+	client := session.EC2Client()
+
+	result := &ReportResult{}
+	err := client.DescribeVolumesPagesWithContext(ctx, &ec2.DescribeVolumesInput{},
+		func(page *ec2.DescribeVolumesOutput, lastPage bool) bool {
+			for _, volume := range page.Volumes {
+				arn := BuildARN(session.Partition(), "ec2",
+					*session.Config.Region,
+					session.AccountID,
+					fmt.Sprintf("volume/%s", *volume.VolumeId),
+				)
+				resource, err := NewResource(arn, volume)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "volume"
+
+				attachments := []map[string]interface{}{}
+				for _, attachment := range volume.Attachments {
+					attachments = append(attachments, map[string]interface{}{
+						"InstanceId": aws.StringValue(attachment.InstanceId),
+						"Device":     aws.StringValue(attachment.Device),
+						"State":      aws.StringValue(attachment.State),
+					})
+				}
+				resource.Metadata["Attachments"] = attachments
+				resource.Metadata["TagsMap"] = EC2TagsToMap(volume.Tags)
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
+
+func EC2ListSnapshots(ctx context.Context, session *Session) *ReportResult {
+	client := session.EC2Client()
+
+	result := &ReportResult{}
+	err := client.DescribeSnapshotsPagesWithContext(ctx, &ec2.DescribeSnapshotsInput{OwnerIds: []*string{aws.String("self")}},
+		func(page *ec2.DescribeSnapshotsOutput, lastPage bool) bool {
+			for _, snapshot := range page.Snapshots {
+				arn := BuildARN(session.Partition(), "ec2",
+					*session.Config.Region,
+					"",
+					fmt.Sprintf("snapshot/%s", *snapshot.SnapshotId),
+				)
+				resource, err := NewResource(arn, snapshot)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "snapshot"
+				resource.AccountID = *snapshot.OwnerId
+				resource.Metadata["TagsMap"] = EC2TagsToMap(snapshot.Tags)
+
+				attribute, err := client.DescribeSnapshotAttributeWithContext(ctx, &ec2.DescribeSnapshotAttributeInput{
+					SnapshotId: snapshot.SnapshotId,
+					Attribute:  aws.String(ec2.SnapshotAttributeNameCreateVolumePermission),
+				})
+				if err != nil {
+					result.AddError(err)
+					resource.Metadata["Error"] = err.Error()
+				} else {
+					public := false
+					permissions := []map[string]interface{}{}
+					for _, permission := range attribute.CreateVolumePermissions {
+						if aws.StringValue(permission.Group) == ec2.PermissionGroupAll {
+							public = true
+						}
+						permissions = append(permissions, structs.Map(permission))
+					}
+					resource.Metadata["CreateVolumePermissions"] = permissions
+					resource.Metadata["Public"] = public
+				}
+
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
+
+func ec2LaunchTemplateDataSummary(data *ec2.ResponseLaunchTemplateData) map[string]interface{} {
+	securityGroups := aws.StringValueSlice(data.SecurityGroupIds)
+	securityGroups = append(securityGroups, aws.StringValueSlice(data.SecurityGroups)...)
+	for _, networkInterface := range data.NetworkInterfaces {
+		securityGroups = append(securityGroups, aws.StringValueSlice(networkInterface.Groups)...)
+	}
+
+	httpTokens := ""
+	if data.MetadataOptions != nil {
+		httpTokens = aws.StringValue(data.MetadataOptions.HttpTokens)
+	}
+
+	return map[string]interface{}{
+		"ImageId":        aws.StringValue(data.ImageId),
+		"InstanceType":   aws.StringValue(data.InstanceType),
+		"KeyName":        aws.StringValue(data.KeyName),
+		"SecurityGroups": securityGroups,
+		"HttpTokens":     httpTokens,
+		"IMDSv2Required": httpTokens == ec2.LaunchTemplateHttpTokensStateRequired,
+		"HasUserData":    aws.StringValue(data.UserData) != "",
+	}
+}
+
+func EC2ListAddresses(ctx context.Context, session *Session) *ReportResult {
+	client := session.EC2Client()
+
+	result := &ReportResult{}
+	res, err := client.DescribeAddressesWithContext(ctx, &ec2.DescribeAddressesInput{})
+	if err != nil {
+		result.Error = err
+		return result
+	}
+
+	for _, address := range res.Addresses {
+		id := aws.StringValue(address.AllocationId)
+		if id == "" {
+			// EC2-Classic addresses don't have an allocation ID
+			id = aws.StringValue(address.PublicIp)
+		}
+
+		resource, err := NewResource(BuildARN(session.Partition(), "ec2",
+			*session.Config.Region,
+			session.AccountID,
+			fmt.Sprintf("elastic-ip/%s", id),
+		), address)
+		if err != nil {
+			result.AddError(err)
+			continue
+		}
+		resource.Type = "elastic-ip"
+		resource.Metadata["Associated"] = address.AssociationId != nil || address.InstanceId != nil || address.NetworkInterfaceId != nil
+		resource.Metadata["TagsMap"] = EC2TagsToMap(address.Tags)
+		result.Resources = append(result.Resources, *resource)
+	}
+
+	return result
+}
+
+func EC2ListNetworkInterfaces(ctx context.Context, session *Session) *ReportResult {
+	client := session.EC2Client()
+
+	result := &ReportResult{}
+	err := client.DescribeNetworkInterfacesPagesWithContext(ctx, &ec2.DescribeNetworkInterfacesInput{},
+		func(page *ec2.DescribeNetworkInterfacesOutput, lastPage bool) bool {
+			for _, networkInterface := range page.NetworkInterfaces {
+				resource, err := NewResource(BuildARN(session.Partition(), "ec2",
+					*session.Config.Region,
+					aws.StringValue(networkInterface.OwnerId),
+					fmt.Sprintf("network-interface/%s", *networkInterface.NetworkInterfaceId),
+				), networkInterface)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "network-interface"
+
+				privateIPs := []string{}
+				publicIPs := []string{}
+				for _, address := range networkInterface.PrivateIpAddresses {
+					privateIPs = append(privateIPs, aws.StringValue(address.PrivateIpAddress))
+					if address.Association != nil && address.Association.PublicIp != nil {
+						publicIPs = append(publicIPs, *address.Association.PublicIp)
+					}
+				}
+				resource.Metadata["PrivateIPs"] = privateIPs
+				resource.Metadata["PublicIPs"] = publicIPs
+
+				securityGroups := []string{}
+				for _, group := range networkInterface.Groups {
+					securityGroups = append(securityGroups, aws.StringValue(group.GroupId))
+				}
+				resource.Metadata["SecurityGroupIds"] = securityGroups
+				resource.Metadata["VpcId"] = aws.StringValue(networkInterface.VpcId)
+				resource.Metadata["TagsMap"] = EC2TagsToMap(networkInterface.TagSet)
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
 }
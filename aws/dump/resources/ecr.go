@@ -0,0 +1,107 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/fatih/structs"
+)
+
+var (
+	ECRService = Service{
+		Name: "ecr",
+		Reports: map[string]Report{
+			"repositories": ECRListRepositories,
+		},
+	}
+)
+
+func init() {
+	RegisterService(ECRService)
+}
+
+func ECRListRepositories(ctx context.Context, session *Session) *ReportResult {
+	client := session.ECRClient()
+
+	result := &ReportResult{}
+	err := client.DescribeRepositoriesPagesWithContext(ctx, &ecr.DescribeRepositoriesInput{},
+		func(page *ecr.DescribeRepositoriesOutput, lastPage bool) bool {
+			for _, repository := range page.Repositories {
+				resource, err := NewResource(*repository.RepositoryArn, repository)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "repository"
+
+				if repository.ImageScanningConfiguration != nil {
+					resource.Metadata["ScanOnPush"] = *repository.ImageScanningConfiguration.ScanOnPush
+				}
+
+				policy, err := client.GetRepositoryPolicyWithContext(ctx, &ecr.GetRepositoryPolicyInput{
+					RepositoryName: repository.RepositoryName,
+				})
+				if err == nil {
+					document, err := DecodeInlinePolicyDocument(*policy.PolicyText)
+					if err != nil {
+						result.AddError(err)
+					} else {
+						resource.Metadata["Policy"] = document
+					}
+				} else if IsAWSErrorCode(err, ecr.ErrCodeRepositoryPolicyNotFoundException) {
+					resource.Metadata["Policy"] = nil
+				} else {
+					result.AddError(err)
+				}
+
+				lifecyclePolicy, err := client.GetLifecyclePolicyWithContext(ctx, &ecr.GetLifecyclePolicyInput{
+					RepositoryName: repository.RepositoryName,
+				})
+				if err == nil {
+					document, err := DecodeInlinePolicyDocument(*lifecyclePolicy.LifecyclePolicyText)
+					if err != nil {
+						result.AddError(err)
+					} else {
+						resource.Metadata["LifecyclePolicy"] = document
+					}
+				} else if IsAWSErrorCode(err, ecr.ErrCodeLifecyclePolicyNotFoundException) {
+					resource.Metadata["LifecyclePolicy"] = nil
+				} else {
+					result.AddError(err)
+				}
+
+				latest, err := ECRLatestImage(ctx, client, *repository.RepositoryName)
+				if err != nil {
+					result.AddError(err)
+				} else if latest != nil {
+					resource.Metadata["LatestImageDigest"] = *latest.ImageDigest
+					if latest.ImageScanFindingsSummary != nil {
+						resource.Metadata["LatestImageScanFindings"] = structs.Map(latest.ImageScanFindingsSummary)
+					}
+				}
+
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
+
+func ECRLatestImage(ctx context.Context, client *ecr.ECR, repositoryName string) (*ecr.ImageDetail, error) {
+	var latest *ecr.ImageDetail
+	err := client.DescribeImagesPagesWithContext(ctx, &ecr.DescribeImagesInput{RepositoryName: &repositoryName},
+		func(page *ecr.DescribeImagesOutput, lastPage bool) bool {
+			for _, image := range page.ImageDetails {
+				if image.ImagePushedAt == nil {
+					continue
+				}
+				if latest == nil || image.ImagePushedAt.After(*latest.ImagePushedAt) {
+					latest = image
+				}
+			}
+			return true
+		})
+	return latest, err
+}
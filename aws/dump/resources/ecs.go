@@ -0,0 +1,183 @@
+package resources
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+var (
+	ECSService = Service{
+		Name: "ecs",
+		Reports: map[string]Report{
+			"clusters":         ECSListClusters,
+			"services":         ECSListServices,
+			"task-definitions": ECSListTaskDefinitions,
+		},
+	}
+)
+
+func init() {
+	RegisterService(ECSService)
+}
+
+// DescribeServices accepts at most 10 services per call.
+const ecsDescribeServicesBatchSize = 10
+
+func ECSListClusters(ctx context.Context, session *Session) *ReportResult {
+	client := session.ECSClient()
+
+	result := &ReportResult{}
+	err := client.ListClustersPagesWithContext(ctx, &ecs.ListClustersInput{},
+		func(page *ecs.ListClustersOutput, lastPage bool) bool {
+			if len(page.ClusterArns) == 0 {
+				return true
+			}
+
+			described, err := client.DescribeClustersWithContext(ctx, &ecs.DescribeClustersInput{
+				Clusters: page.ClusterArns,
+				Include:  aws.StringSlice([]string{ecs.ClusterFieldSettings, ecs.ClusterFieldTags}),
+			})
+			if err != nil {
+				result.AddError(err)
+				return true
+			}
+
+			for _, cluster := range described.Clusters {
+				resource, err := NewResource(*cluster.ClusterArn, cluster)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "cluster"
+				resource.Metadata["TagsMap"] = ECSTagsToMap(cluster.Tags)
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
+
+func ECSListServices(ctx context.Context, session *Session) *ReportResult {
+	client := session.ECSClient()
+
+	result := &ReportResult{}
+	err := client.ListClustersPagesWithContext(ctx, &ecs.ListClustersInput{},
+		func(page *ecs.ListClustersOutput, lastPage bool) bool {
+			for _, clusterArn := range page.ClusterArns {
+				result.AddError(ecsListClusterServices(ctx, client, clusterArn, result))
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
+
+func ecsListClusterServices(ctx context.Context, client *ecs.ECS, clusterArn *string, result *ReportResult) error {
+	serviceArns := []*string{}
+	err := client.ListServicesPagesWithContext(ctx, &ecs.ListServicesInput{Cluster: clusterArn},
+		func(page *ecs.ListServicesOutput, lastPage bool) bool {
+			serviceArns = append(serviceArns, page.ServiceArns...)
+			return true
+		})
+	if err != nil {
+		return err
+	}
+
+	for start := 0; start < len(serviceArns); start += ecsDescribeServicesBatchSize {
+		end := start + ecsDescribeServicesBatchSize
+		if end > len(serviceArns) {
+			end = len(serviceArns)
+		}
+
+		described, err := client.DescribeServicesWithContext(ctx, &ecs.DescribeServicesInput{
+			Cluster:  clusterArn,
+			Services: serviceArns[start:end],
+			Include:  aws.StringSlice([]string{ecs.ServiceFieldTags}),
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, service := range described.Services {
+			resource, err := NewResource(*service.ServiceArn, service)
+			if err != nil {
+				result.AddError(err)
+				continue
+			}
+			resource.Type = "service"
+			// Events is a rolling log of deployments and isn't useful in a dump.
+			delete(resource.Metadata, "Events")
+			resource.Metadata["TagsMap"] = ECSTagsToMap(service.Tags)
+			result.Resources = append(result.Resources, *resource)
+		}
+	}
+	return nil
+}
+
+func ECSListTaskDefinitions(ctx context.Context, session *Session) *ReportResult {
+	client := session.ECSClient()
+
+	result := &ReportResult{}
+	err := client.ListTaskDefinitionFamiliesPagesWithContext(ctx, &ecs.ListTaskDefinitionFamiliesInput{
+		Status: aws.String(ecs.TaskDefinitionFamilyStatusActive),
+	},
+		func(page *ecs.ListTaskDefinitionFamiliesOutput, lastPage bool) bool {
+			for _, family := range page.Families {
+				// Describing a family returns its latest active revision.
+				described, err := client.DescribeTaskDefinitionWithContext(ctx, &ecs.DescribeTaskDefinitionInput{
+					TaskDefinition: family,
+					Include:        aws.StringSlice([]string{ecs.TaskDefinitionFieldTags}),
+				})
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				taskDefinition := described.TaskDefinition
+
+				// Environment values can contain secrets, only keep the names.
+				images := []string{}
+				environmentVariableNames := map[string][]string{}
+				for _, container := range taskDefinition.ContainerDefinitions {
+					images = append(images, aws.StringValue(container.Image))
+
+					names := []string{}
+					for _, variable := range container.Environment {
+						names = append(names, aws.StringValue(variable.Name))
+					}
+					sort.Strings(names)
+					environmentVariableNames[aws.StringValue(container.Name)] = names
+					container.Environment = nil
+				}
+
+				resource, err := NewResource(*taskDefinition.TaskDefinitionArn, taskDefinition)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "task-definition"
+				resource.Metadata["EnvironmentVariableNames"] = environmentVariableNames
+				resource.Metadata["Images"] = images
+				resource.Metadata["TaskRoleArn"] = aws.StringValue(taskDefinition.TaskRoleArn)
+				resource.Metadata["TagsMap"] = ECSTagsToMap(described.Tags)
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
+
+func ECSTagsToMap(tags []*ecs.Tag) map[string]string {
+	tagsMap := map[string]string{}
+	for _, tag := range tags {
+		tagsMap[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return tagsMap
+}
@@ -0,0 +1,108 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/fatih/structs"
+)
+
+var (
+	EFSService = Service{
+		Name: "elasticfilesystem",
+		Reports: map[string]Report{
+			"file-systems": EFSListFileSystems,
+		},
+	}
+)
+
+func init() {
+	RegisterService(EFSService)
+}
+
+func EFSListFileSystems(ctx context.Context, session *Session) *ReportResult {
+	client := session.EFSClient()
+
+	result := &ReportResult{}
+	err := client.DescribeFileSystemsPagesWithContext(ctx, &efs.DescribeFileSystemsInput{},
+		func(page *efs.DescribeFileSystemsOutput, lastPage bool) bool {
+			for _, fileSystem := range page.FileSystems {
+				resource, err := NewResource(*fileSystem.FileSystemArn, fileSystem)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "file-system"
+				resource.Metadata["TagsMap"] = EFSTagsToMap(fileSystem.Tags)
+
+				policy, err := client.DescribeFileSystemPolicyWithContext(ctx, &efs.DescribeFileSystemPolicyInput{
+					FileSystemId: fileSystem.FileSystemId,
+				})
+				if err == nil {
+					document, err := DecodeInlinePolicyDocument(*policy.Policy)
+					if err != nil {
+						result.AddError(err)
+					} else {
+						resource.Metadata["Policy"] = document
+					}
+				} else if IsAWSErrorCode(err, efs.ErrCodePolicyNotFound) {
+					resource.Metadata["Policy"] = nil
+				} else {
+					result.AddError(err)
+				}
+
+				lifecycle, err := client.DescribeLifecycleConfigurationWithContext(ctx, &efs.DescribeLifecycleConfigurationInput{
+					FileSystemId: fileSystem.FileSystemId,
+				})
+				if err != nil {
+					result.AddError(err)
+				} else {
+					policies := []map[string]interface{}{}
+					for _, policy := range lifecycle.LifecyclePolicies {
+						policies = append(policies, structs.Map(policy))
+					}
+					resource.Metadata["LifecyclePolicies"] = policies
+				}
+
+				mountTargets, err := EFSListMountTargets(ctx, client, *fileSystem.FileSystemId)
+				if err != nil {
+					result.AddError(err)
+					resource.Metadata["Error"] = err.Error()
+				}
+				resource.Metadata["MountTargets"] = mountTargets
+
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
+
+func EFSListMountTargets(ctx context.Context, client *efs.EFS, fileSystemID string) ([]map[string]interface{}, error) {
+	mountTargets := []map[string]interface{}{}
+	input := &efs.DescribeMountTargetsInput{FileSystemId: aws.String(fileSystemID)}
+	for {
+		page, err := client.DescribeMountTargetsWithContext(ctx, input)
+		if err != nil {
+			return mountTargets, err
+		}
+		for _, mountTarget := range page.MountTargets {
+			mountTargets = append(mountTargets, structs.Map(mountTarget))
+		}
+		if page.NextMarker == nil {
+			return mountTargets, nil
+		}
+		input.Marker = page.NextMarker
+	}
+}
+
+func EFSTagsToMap(tags []*efs.Tag) map[string]string {
+	tagsMap := map[string]string{}
+	for _, tag := range tags {
+		tagsMap[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return tagsMap
+}
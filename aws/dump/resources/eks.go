@@ -0,0 +1,107 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/fatih/structs"
+)
+
+var (
+	EKSService = Service{
+		Name: "eks",
+		Reports: map[string]Report{
+			"clusters": EKSListClusters,
+		},
+	}
+)
+
+func init() {
+	RegisterService(EKSService)
+}
+
+func EKSListClusters(ctx context.Context, session *Session) *ReportResult {
+	client := session.EKSClient()
+
+	result := &ReportResult{}
+	err := client.ListClustersPagesWithContext(ctx, &eks.ListClustersInput{},
+		func(page *eks.ListClustersOutput, lastPage bool) bool {
+			for _, name := range page.Clusters {
+				described, err := client.DescribeClusterWithContext(ctx, &eks.DescribeClusterInput{Name: name})
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				cluster := described.Cluster
+
+				resource, err := NewResource(*cluster.Arn, cluster)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "cluster"
+
+				publicAccess := false
+				if vpc := cluster.ResourcesVpcConfig; vpc != nil && aws.BoolValue(vpc.EndpointPublicAccess) {
+					for _, cidr := range vpc.PublicAccessCidrs {
+						if *cidr == "0.0.0.0/0" {
+							publicAccess = true
+						}
+					}
+				}
+				resource.Metadata["OpenPublicEndpoint"] = publicAccess
+
+				loggingTypes := []string{}
+				if cluster.Logging != nil {
+					for _, setup := range cluster.Logging.ClusterLogging {
+						if aws.BoolValue(setup.Enabled) {
+							loggingTypes = append(loggingTypes, aws.StringValueSlice(setup.Types)...)
+						}
+					}
+				}
+				resource.Metadata["EnabledLoggingTypes"] = loggingTypes
+
+				if cluster.Identity != nil && cluster.Identity.Oidc != nil {
+					resource.Metadata["OIDCIssuer"] = aws.StringValue(cluster.Identity.Oidc.Issuer)
+				}
+
+				nodegroups, err := EKSListNodegroups(ctx, client, *cluster.Name)
+				if err != nil {
+					result.AddError(err)
+					resource.Metadata["Error"] = err.Error()
+				}
+				resource.Metadata["Nodegroups"] = nodegroups
+
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
+
+func EKSListNodegroups(ctx context.Context, client *eks.EKS, clusterName string) ([]map[string]interface{}, error) {
+	nodegroups := []map[string]interface{}{}
+	var describeErr error
+	err := client.ListNodegroupsPagesWithContext(ctx, &eks.ListNodegroupsInput{ClusterName: &clusterName},
+		func(page *eks.ListNodegroupsOutput, lastPage bool) bool {
+			for _, name := range page.Nodegroups {
+				described, err := client.DescribeNodegroupWithContext(ctx, &eks.DescribeNodegroupInput{
+					ClusterName:   &clusterName,
+					NodegroupName: name,
+				})
+				if err != nil {
+					describeErr = err
+					return false
+				}
+				nodegroups = append(nodegroups, structs.Map(described.Nodegroup))
+			}
+			return true
+		})
+	if err == nil {
+		err = describeErr
+	}
+	return nodegroups, err
+}
@@ -0,0 +1,71 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+)
+
+var (
+	ElastiCacheService = Service{
+		Name: "elasticache",
+		Reports: map[string]Report{
+			"clusters":           ECacheListClusters,
+			"replication-groups": ECacheListReplicationGroups,
+		},
+	}
+)
+
+func init() {
+	RegisterService(ElastiCacheService)
+}
+
+func ECacheListClusters(ctx context.Context, session *Session) *ReportResult {
+	client := session.ElastiCacheClient()
+
+	result := &ReportResult{}
+	err := client.DescribeCacheClustersPagesWithContext(ctx, &elasticache.DescribeCacheClustersInput{},
+		func(page *elasticache.DescribeCacheClustersOutput, lastPage bool) bool {
+			for _, cluster := range page.CacheClusters {
+				resource, err := NewResource(*cluster.ARN, cluster)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "cluster"
+				resource.Metadata["TransitEncryptionEnabled"] = aws.BoolValue(cluster.TransitEncryptionEnabled)
+				resource.Metadata["AtRestEncryptionEnabled"] = aws.BoolValue(cluster.AtRestEncryptionEnabled)
+				resource.Metadata["AuthTokenEnabled"] = aws.BoolValue(cluster.AuthTokenEnabled)
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
+
+func ECacheListReplicationGroups(ctx context.Context, session *Session) *ReportResult {
+	client := session.ElastiCacheClient()
+
+	result := &ReportResult{}
+	err := client.DescribeReplicationGroupsPagesWithContext(ctx, &elasticache.DescribeReplicationGroupsInput{},
+		func(page *elasticache.DescribeReplicationGroupsOutput, lastPage bool) bool {
+			for _, group := range page.ReplicationGroups {
+				resource, err := NewResource(*group.ARN, group)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "replication-group"
+				resource.Metadata["MultiAZEnabled"] = aws.StringValue(group.MultiAZ) == elasticache.MultiAZStatusEnabled
+				resource.Metadata["AutomaticFailoverEnabled"] = aws.StringValue(group.AutomaticFailover) == elasticache.AutomaticFailoverStatusEnabled
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
@@ -0,0 +1,122 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+)
+
+var (
+	ElasticBeanstalkService = Service{
+		Name: "elasticbeanstalk",
+		Reports: map[string]Report{
+			"applications": ElasticBeanstalkListApplications,
+			"environments": ElasticBeanstalkListEnvironments,
+		},
+	}
+)
+
+func init() {
+	RegisterService(ElasticBeanstalkService)
+}
+
+func ElasticBeanstalkListApplications(ctx context.Context, session *Session) *ReportResult {
+	client := session.ElasticBeanstalkClient()
+
+	result := &ReportResult{}
+	applications, err := client.DescribeApplicationsWithContext(ctx, &elasticbeanstalk.DescribeApplicationsInput{})
+	if err != nil {
+		result.AddError(err)
+		return result
+	}
+
+	for _, application := range applications.Applications {
+		resource, err := NewResource(*application.ApplicationArn, application)
+		if err != nil {
+			result.AddError(err)
+			continue
+		}
+		resource.Type = "application"
+		serviceRole := ""
+		if application.ResourceLifecycleConfig != nil {
+			serviceRole = aws.StringValue(application.ResourceLifecycleConfig.ServiceRole)
+		}
+		resource.Metadata["ServiceRole"] = serviceRole
+		result.Resources = append(result.Resources, *resource)
+	}
+	return result
+}
+
+func ElasticBeanstalkListEnvironments(ctx context.Context, session *Session) *ReportResult {
+	client := session.ElasticBeanstalkClient()
+
+	result := &ReportResult{}
+	input := &elasticbeanstalk.DescribeEnvironmentsInput{IncludeDeleted: aws.Bool(false)}
+	for {
+		page, err := client.DescribeEnvironmentsWithContext(ctx, input)
+		if err != nil {
+			result.AddError(err)
+			return result
+		}
+
+		for _, environment := range page.Environments {
+			resource, err := NewResource(*environment.EnvironmentArn, environment)
+			if err != nil {
+				result.AddError(err)
+				continue
+			}
+			resource.Type = "environment"
+			resource.Metadata["SolutionStackName"] = aws.StringValue(environment.SolutionStackName)
+			resource.Metadata["Health"] = aws.StringValue(environment.Health)
+			resource.Metadata["HealthStatus"] = aws.StringValue(environment.HealthStatus)
+			tier := ""
+			if environment.Tier != nil {
+				tier = aws.StringValue(environment.Tier.Name)
+			}
+			resource.Metadata["Tier"] = tier
+
+			instanceProfile, serviceRole, err := elasticBeanstalkRoles(ctx, client, environment)
+			if err != nil {
+				result.AddError(err)
+				resource.Metadata["Error"] = err.Error()
+			} else {
+				resource.Metadata["InstanceProfile"] = instanceProfile
+				resource.Metadata["ServiceRole"] = serviceRole
+			}
+
+			result.Resources = append(result.Resources, *resource)
+		}
+
+		if page.NextToken == nil {
+			return result
+		}
+		input.NextToken = page.NextToken
+	}
+}
+
+// elasticBeanstalkRoles reads the IAM settings of an environment. Only those
+// options are kept, the others include the environment properties which can
+// hold secrets.
+func elasticBeanstalkRoles(ctx context.Context, client *elasticbeanstalk.ElasticBeanstalk, environment *elasticbeanstalk.EnvironmentDescription) (string, string, error) {
+	settings, err := client.DescribeConfigurationSettingsWithContext(ctx, &elasticbeanstalk.DescribeConfigurationSettingsInput{
+		ApplicationName: environment.ApplicationName,
+		EnvironmentName: environment.EnvironmentName,
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	instanceProfile, serviceRole := "", ""
+	for _, configuration := range settings.ConfigurationSettings {
+		for _, option := range configuration.OptionSettings {
+			switch {
+			case aws.StringValue(option.Namespace) == "aws:autoscaling:launchconfiguration" && aws.StringValue(option.OptionName) == "IamInstanceProfile":
+				instanceProfile = aws.StringValue(option.Value)
+			case aws.StringValue(option.Namespace) == "aws:elasticbeanstalk:environment" && aws.StringValue(option.OptionName) == "ServiceRole":
+				serviceRole = aws.StringValue(option.Value)
+			}
+		}
+	}
+	return instanceProfile, serviceRole, nil
+}
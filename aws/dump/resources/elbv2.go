@@ -0,0 +1,121 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/fatih/structs"
+)
+
+var (
+	ELBv2Service = Service{
+		Name: "elbv2",
+		Reports: map[string]Report{
+			"load-balancers":         ELBv2ListLoadBalancers,
+			"classic-load-balancers": ELBListClassicLoadBalancers,
+		},
+	}
+)
+
+func init() {
+	RegisterService(ELBv2Service)
+}
+
+func ELBv2ListLoadBalancers(ctx context.Context, session *Session) *ReportResult {
+	client := session.ELBV2Client()
+
+	result := &ReportResult{}
+	err := client.DescribeLoadBalancersPagesWithContext(ctx, &elbv2.DescribeLoadBalancersInput{},
+		func(page *elbv2.DescribeLoadBalancersOutput, lastPage bool) bool {
+			for _, loadBalancer := range page.LoadBalancers {
+				resource, err := NewResource(*loadBalancer.LoadBalancerArn, loadBalancer)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "load-balancer"
+
+				subnets := []string{}
+				for _, zone := range loadBalancer.AvailabilityZones {
+					if zone.SubnetId != nil {
+						subnets = append(subnets, *zone.SubnetId)
+					}
+				}
+				resource.Metadata["Subnets"] = subnets
+
+				listeners, err := ELBv2ListListeners(ctx, client, *loadBalancer.LoadBalancerArn)
+				if err != nil {
+					result.AddError(err)
+					resource.Metadata["ListenersError"] = err.Error()
+				}
+				resource.Metadata["Listeners"] = listeners
+
+				targetGroups, err := ELBv2ListTargetGroups(ctx, client, *loadBalancer.LoadBalancerArn)
+				if err != nil {
+					result.AddError(err)
+					resource.Metadata["TargetGroupsError"] = err.Error()
+				}
+				resource.Metadata["TargetGroups"] = targetGroups
+
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
+
+func ELBv2ListListeners(ctx context.Context, client *elbv2.ELBV2, loadBalancerArn string) ([]map[string]interface{}, error) {
+	listeners := []map[string]interface{}{}
+	err := client.DescribeListenersPagesWithContext(ctx, &elbv2.DescribeListenersInput{LoadBalancerArn: &loadBalancerArn},
+		func(page *elbv2.DescribeListenersOutput, lastPage bool) bool {
+			for _, listener := range page.Listeners {
+				listeners = append(listeners, structs.Map(listener))
+			}
+			return true
+		})
+	return listeners, err
+}
+
+func ELBv2ListTargetGroups(ctx context.Context, client *elbv2.ELBV2, loadBalancerArn string) ([]map[string]interface{}, error) {
+	targetGroups := []map[string]interface{}{}
+	err := client.DescribeTargetGroupsPagesWithContext(ctx, &elbv2.DescribeTargetGroupsInput{LoadBalancerArn: &loadBalancerArn},
+		func(page *elbv2.DescribeTargetGroupsOutput, lastPage bool) bool {
+			for _, targetGroup := range page.TargetGroups {
+				targetGroups = append(targetGroups, structs.Map(targetGroup))
+			}
+			return true
+		})
+	return targetGroups, err
+}
+
+func ELBListClassicLoadBalancers(ctx context.Context, session *Session) *ReportResult {
+	client := session.ELBClient()
+
+	result := &ReportResult{}
+	err := client.DescribeLoadBalancersPagesWithContext(ctx, &elb.DescribeLoadBalancersInput{},
+		func(page *elb.DescribeLoadBalancersOutput, lastPage bool) bool {
+			for _, loadBalancer := range page.LoadBalancerDescriptions {
+				arn := BuildARN(session.Partition(), "elasticloadbalancing",
+					*session.Config.Region,
+					session.AccountID,
+					fmt.Sprintf("loadbalancer/%s", *loadBalancer.LoadBalancerName),
+				)
+
+				resource, err := NewResource(arn, loadBalancer)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "classic-load-balancer"
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
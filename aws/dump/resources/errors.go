@@ -0,0 +1,36 @@
+package resources
+
+import (
+	"strings"
+)
+
+type MultiError struct {
+	Errors []error
+}
+
+func (m *MultiError) Append(err error) {
+	if err == nil {
+		return
+	}
+
+	if other, ok := err.(*MultiError); ok {
+		m.Errors = append(m.Errors, other.Errors...)
+		return
+	}
+	m.Errors = append(m.Errors, err)
+}
+
+func (m *MultiError) ErrorOrNil() error {
+	if m == nil || len(m.Errors) == 0 {
+		return nil
+	}
+	return m
+}
+
+func (m *MultiError) Error() string {
+	messages := make([]string, 0, len(m.Errors))
+	for _, err := range m.Errors {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "; ")
+}
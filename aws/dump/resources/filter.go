@@ -0,0 +1,66 @@
+package resources
+
+func extractTags(metadata map[string]interface{}) map[string]string {
+	if tagsMap, ok := metadata["TagsMap"].(map[string]string); ok {
+		return tagsMap
+	}
+
+	tags := map[string]string{}
+	for _, field := range []string{"Tags", "TagList"} {
+		list, ok := metadata[field].([]interface{})
+		if !ok {
+			continue
+		}
+		for _, item := range list {
+			tag, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			key, ok := NormalizeValue(tag["Key"]).(string)
+			if !ok {
+				continue
+			}
+			value, _ := NormalizeValue(tag["Value"]).(string)
+			tags[key] = value
+		}
+	}
+	return tags
+}
+
+func (r *ReportResult) filter(keep func(Resource) bool) *ReportResult {
+	filtered := &ReportResult{Error: r.Error, Metrics: r.Metrics, Truncated: r.Truncated}
+	for _, resource := range r.Resources {
+		if keep(resource) {
+			filtered.Resources = append(filtered.Resources, resource)
+		}
+	}
+	return filtered
+}
+
+func (r *ReportResult) FilterByTag(key, value string) *ReportResult {
+	return r.filter(func(resource Resource) bool {
+		tagValue, ok := extractTags(resource.Metadata)[key]
+		return ok && tagValue == value
+	})
+}
+
+func (r *ReportResult) FilterByType(types ...string) *ReportResult {
+	return r.filter(func(resource Resource) bool {
+		return containsString(types, resource.Type)
+	})
+}
+
+func (r *ReportResult) FilterByService(services ...string) *ReportResult {
+	return r.filter(func(resource Resource) bool {
+		return containsString(services, resource.Service)
+	})
+}
+
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,89 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fsx"
+)
+
+var (
+	FSxService = Service{
+		Name: "fsx",
+		Reports: map[string]Report{
+			"file-systems": FSxListFileSystems,
+		},
+	}
+)
+
+func init() {
+	RegisterService(FSxService)
+}
+
+func FSxListFileSystems(ctx context.Context, session *Session) *ReportResult {
+	client := session.FSxClient()
+
+	result := &ReportResult{}
+	err := client.DescribeFileSystemsPagesWithContext(ctx, &fsx.DescribeFileSystemsInput{},
+		func(page *fsx.DescribeFileSystemsOutput, lastPage bool) bool {
+			for _, fileSystem := range page.FileSystems {
+				if fileSystem.OntapConfiguration != nil {
+					fileSystem.OntapConfiguration.FsxAdminPassword = nil
+				}
+
+				resource, err := NewResource(*fileSystem.ResourceARN, fileSystem)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "file-system"
+				resource.Metadata["FileSystemType"] = aws.StringValue(fileSystem.FileSystemType)
+				resource.Metadata["StorageCapacity"] = aws.Int64Value(fileSystem.StorageCapacity)
+				resource.Metadata["KmsKeyId"] = aws.StringValue(fileSystem.KmsKeyId)
+				resource.Metadata["VpcId"] = aws.StringValue(fileSystem.VpcId)
+				resource.Metadata["SubnetIds"] = aws.StringValueSlice(fileSystem.SubnetIds)
+				resource.Metadata["Backup"] = fsxBackupSettings(fileSystem)
+				resource.Metadata["TagsMap"] = FSxTagsToMap(fileSystem.Tags)
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
+
+// fsxBackupSettings returns the automatic backup settings, they live in the
+// configuration specific to each file system type.
+func fsxBackupSettings(fileSystem *fsx.FileSystem) map[string]interface{} {
+	var retentionDays *int64
+	var startTime *string
+	switch {
+	case fileSystem.WindowsConfiguration != nil:
+		retentionDays = fileSystem.WindowsConfiguration.AutomaticBackupRetentionDays
+		startTime = fileSystem.WindowsConfiguration.DailyAutomaticBackupStartTime
+	case fileSystem.LustreConfiguration != nil:
+		retentionDays = fileSystem.LustreConfiguration.AutomaticBackupRetentionDays
+		startTime = fileSystem.LustreConfiguration.DailyAutomaticBackupStartTime
+	case fileSystem.OntapConfiguration != nil:
+		retentionDays = fileSystem.OntapConfiguration.AutomaticBackupRetentionDays
+		startTime = fileSystem.OntapConfiguration.DailyAutomaticBackupStartTime
+	case fileSystem.OpenZFSConfiguration != nil:
+		retentionDays = fileSystem.OpenZFSConfiguration.AutomaticBackupRetentionDays
+		startTime = fileSystem.OpenZFSConfiguration.DailyAutomaticBackupStartTime
+	}
+
+	return map[string]interface{}{
+		"AutomaticBackupsEnabled":       aws.Int64Value(retentionDays) > 0,
+		"AutomaticBackupRetentionDays":  aws.Int64Value(retentionDays),
+		"DailyAutomaticBackupStartTime": aws.StringValue(startTime),
+	}
+}
+
+func FSxTagsToMap(tags []*fsx.Tag) map[string]string {
+	tagsMap := map[string]string{}
+	for _, tag := range tags {
+		tagsMap[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return tagsMap
+}
@@ -0,0 +1,138 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/fatih/structs"
+)
+
+var (
+	GlueService = Service{
+		Name: "glue",
+		Reports: map[string]Report{
+			"databases": GlueListDatabases,
+			"crawlers":  GlueListCrawlers,
+			"jobs":      GlueListJobs,
+		},
+	}
+)
+
+func init() {
+	RegisterService(GlueService)
+}
+
+func glueResource(session *Session, resourceType, name string, metadata interface{}) Resource {
+	return Resource{
+		ID: name,
+		ARN: BuildARN(session.Partition(), "glue",
+			*session.Config.Region,
+			session.AccountID,
+			fmt.Sprintf("%s/%s", resourceType, name),
+		),
+		AccountID: session.AccountID,
+		Service:   "glue",
+		Type:      resourceType,
+		Region:    *session.Config.Region,
+		Metadata:  structs.Map(metadata),
+	}
+}
+
+func GlueListDatabases(ctx context.Context, session *Session) *ReportResult {
+	client := session.GlueClient()
+
+	result := &ReportResult{}
+	err := client.GetDatabasesPagesWithContext(ctx, &glue.GetDatabasesInput{},
+		func(page *glue.GetDatabasesOutput, lastPage bool) bool {
+			for _, database := range page.DatabaseList {
+				result.Resources = append(result.Resources, glueResource(session, "database", *database.Name, database))
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
+
+func GlueListCrawlers(ctx context.Context, session *Session) *ReportResult {
+	client := session.GlueClient()
+
+	result := &ReportResult{}
+	err := client.GetCrawlersPagesWithContext(ctx, &glue.GetCrawlersInput{},
+		func(page *glue.GetCrawlersOutput, lastPage bool) bool {
+			for _, crawler := range page.Crawlers {
+				resource := glueResource(session, "crawler", *crawler.Name, crawler)
+				resource.Metadata["Role"] = aws.StringValue(crawler.Role)
+
+				s3Targets := []string{}
+				if crawler.Targets != nil {
+					for _, target := range crawler.Targets.S3Targets {
+						s3Targets = append(s3Targets, aws.StringValue(target.Path))
+					}
+				}
+				resource.Metadata["S3Targets"] = s3Targets
+
+				schedule := ""
+				if crawler.Schedule != nil {
+					schedule = aws.StringValue(crawler.Schedule.ScheduleExpression)
+				}
+				resource.Metadata["Schedule"] = schedule
+				result.Resources = append(result.Resources, resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
+
+func GlueListJobs(ctx context.Context, session *Session) *ReportResult {
+	client := session.GlueClient()
+
+	result := &ReportResult{}
+	err := client.GetJobsPagesWithContext(ctx, &glue.GetJobsInput{},
+		func(page *glue.GetJobsOutput, lastPage bool) bool {
+			for _, job := range page.Jobs {
+				// Arguments can contain credentials, only keep the names.
+				// The visual editor nodes are large and flagged sensitive.
+				argumentNames := []string{}
+				for name := range job.DefaultArguments {
+					argumentNames = append(argumentNames, name)
+				}
+				for name := range job.NonOverridableArguments {
+					argumentNames = append(argumentNames, name)
+				}
+				sort.Strings(argumentNames)
+				job.DefaultArguments = nil
+				job.NonOverridableArguments = nil
+				job.CodeGenConfigurationNodes = nil
+				if job.SourceControlDetails != nil {
+					job.SourceControlDetails.AuthToken = nil
+				}
+
+				resource := glueResource(session, "job", *job.Name, job)
+				resource.Metadata["ArgumentNames"] = argumentNames
+				resource.Metadata["Role"] = aws.StringValue(job.Role)
+
+				scriptLocation := ""
+				if job.Command != nil {
+					scriptLocation = aws.StringValue(job.Command.ScriptLocation)
+				}
+				resource.Metadata["ScriptLocation"] = scriptLocation
+
+				connections := []string{}
+				if job.Connections != nil {
+					connections = aws.StringValueSlice(job.Connections.Connections)
+				}
+				resource.Metadata["Connections"] = connections
+				result.Resources = append(result.Resources, resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
@@ -0,0 +1,135 @@
+package resources
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Edge struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Relation string `json:"relation"`
+}
+
+type Graph struct {
+	Nodes []Resource `json:"nodes"`
+	Edges []Edge     `json:"edges"`
+}
+
+const (
+	RelationUsesSecurityGroup  = "uses-security-group"
+	RelationHasInstanceProfile = "has-instance-profile"
+	RelationHasRole            = "has-role"
+	RelationHasAttachedPolicy  = "has-attached-policy"
+	RelationInSubnet           = "in-subnet"
+	RelationInVpc              = "in-vpc"
+)
+
+// graphReference describes where a resource type references other resources
+// in its metadata. Lookups are done by ARN when the value is one, otherwise
+// by ID among the resources of TargetType.
+type graphReference struct {
+	SourceType string
+	Path       []string
+	TargetType string
+	Relation   string
+}
+
+var graphReferences = []graphReference{
+	{"instance", []string{"SecurityGroups", "GroupId"}, "security-group", RelationUsesSecurityGroup},
+	{"instance", []string{"IamInstanceProfile", "Arn"}, "instance-profile", RelationHasInstanceProfile},
+	{"instance", []string{"SubnetId"}, "subnet", RelationInSubnet},
+	{"instance", []string{"VpcId"}, "vpc", RelationInVpc},
+	{"instance-profile", []string{"Roles", "Arn"}, "role", RelationHasRole},
+	{"role", []string{"AttachedPolicies", "PolicyArn"}, "policy", RelationHasAttachedPolicy},
+	{"subnet", []string{"VpcId"}, "vpc", RelationInVpc},
+	{"security-group", []string{"VpcId"}, "vpc", RelationInVpc},
+	{"route-table", []string{"VpcId"}, "vpc", RelationInVpc},
+	{"network-interface", []string{"Groups", "GroupId"}, "security-group", RelationUsesSecurityGroup},
+	{"network-interface", []string{"SubnetId"}, "subnet", RelationInSubnet},
+	{"nat-gateway", []string{"SubnetId"}, "subnet", RelationInSubnet},
+	{"function", []string{"Role"}, "role", RelationHasRole},
+	{"function", []string{"VpcConfig", "SecurityGroupIds"}, "security-group", RelationUsesSecurityGroup},
+	{"function", []string{"VpcConfig", "SubnetIds"}, "subnet", RelationInSubnet},
+}
+
+// BuildGraph links the resources of the results using the references found in
+// their metadata. Only edges between resources present in the results are
+// returned.
+func BuildGraph(results ...*ReportResult) *Graph {
+	graph := &Graph{Nodes: []Resource{}, Edges: []Edge{}}
+
+	byID := map[string]string{}
+	known := map[string]bool{}
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+		for _, resource := range result.Resources {
+			id := resource.UniqueID()
+			if known[id] {
+				continue
+			}
+			known[id] = true
+			graph.Nodes = append(graph.Nodes, resource)
+			byID[graphKey(resource.Type, resource.AccountID, resource.Region, resource.ID)] = id
+		}
+	}
+
+	seen := map[Edge]bool{}
+	for _, node := range graph.Nodes {
+		var metadata map[string]interface{}
+		for _, reference := range graphReferences {
+			if reference.SourceType != node.Type {
+				continue
+			}
+			if metadata == nil {
+				metadata, _ = NormalizeValue(node.Metadata).(map[string]interface{})
+			}
+
+			for _, value := range collectStrings(metadata, reference.Path) {
+				target := value
+				if !strings.HasPrefix(value, "arn:") {
+					target = byID[graphKey(reference.TargetType, node.AccountID, node.Region, value)]
+				}
+				if target == "" || !known[target] || target == node.UniqueID() {
+					continue
+				}
+
+				edge := Edge{From: node.UniqueID(), To: target, Relation: reference.Relation}
+				if !seen[edge] {
+					seen[edge] = true
+					graph.Edges = append(graph.Edges, edge)
+				}
+			}
+		}
+	}
+	return graph
+}
+
+func graphKey(resourceType, accountID, region, id string) string {
+	return fmt.Sprintf("%s|%s|%s|%s", resourceType, accountID, region, id)
+}
+
+// collectStrings follows path through nested maps, flattening lists along the
+// way, and returns the strings found at the end of it.
+func collectStrings(value interface{}, path []string) []string {
+	switch typed := value.(type) {
+	case []interface{}:
+		values := []string{}
+		for _, item := range typed {
+			values = append(values, collectStrings(item, path)...)
+		}
+		return values
+	case map[string]interface{}:
+		if len(path) == 0 {
+			return nil
+		}
+		return collectStrings(typed[path[0]], path[1:])
+	case string:
+		if len(path) == 0 && typed != "" {
+			return []string{typed}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/fatih/structs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildGraph(t *testing.T) {
+	t.Parallel()
+
+	instance := ec2.Instance{
+		InstanceId:         aws.String("i-1"),
+		SubnetId:           aws.String("subnet-1"),
+		VpcId:              aws.String("vpc-1"),
+		SecurityGroups:     []*ec2.GroupIdentifier{{GroupId: aws.String("sg-1")}, {GroupId: aws.String("sg-missing")}},
+		IamInstanceProfile: &ec2.IamInstanceProfile{Arn: aws.String("arn:aws:iam::123456789012:instance-profile/web")},
+	}
+	resource := func(arn, id, resourceType string, metadata map[string]interface{}) Resource {
+		return Resource{ARN: arn, ID: id, Type: resourceType, AccountID: "123456789012", Region: "eu-west-1", Metadata: metadata}
+	}
+
+	ec2Result := &ReportResult{Resources: []Resource{
+		resource("arn:aws:ec2:eu-west-1:123456789012:instance/i-1", "i-1", "instance", structs.Map(instance)),
+		resource("arn:aws:ec2:eu-west-1:123456789012:subnet/subnet-1", "subnet-1", "subnet", map[string]interface{}{"VpcId": aws.String("vpc-1")}),
+		resource("arn:aws:ec2:eu-west-1:123456789012:vpc/vpc-1", "vpc-1", "vpc", map[string]interface{}{}),
+		resource("arn:aws:ec2:eu-west-1:123456789012:security-group/sg-1", "sg-1", "security-group", map[string]interface{}{"VpcId": "vpc-1"}),
+	}}
+	iamResult := &ReportResult{Resources: []Resource{
+		resource("arn:aws:iam::123456789012:instance-profile/web", "web", "instance-profile", map[string]interface{}{
+			"Roles": []interface{}{map[string]interface{}{"Arn": aws.String("arn:aws:iam::123456789012:role/web")}},
+		}),
+		resource("arn:aws:iam::123456789012:role/web", "web", "role", map[string]interface{}{
+			"AttachedPolicies": []map[string]interface{}{{"PolicyArn": aws.String("arn:aws:iam::123456789012:policy/web")}},
+		}),
+		resource("arn:aws:iam::123456789012:policy/web", "web", "policy", map[string]interface{}{}),
+	}}
+
+	graph := BuildGraph(ec2Result, iamResult)
+	require.Len(t, graph.Nodes, 7)
+	require.ElementsMatch(t, []Edge{
+		{"arn:aws:ec2:eu-west-1:123456789012:instance/i-1", "arn:aws:ec2:eu-west-1:123456789012:security-group/sg-1", RelationUsesSecurityGroup},
+		{"arn:aws:ec2:eu-west-1:123456789012:instance/i-1", "arn:aws:iam::123456789012:instance-profile/web", RelationHasInstanceProfile},
+		{"arn:aws:ec2:eu-west-1:123456789012:instance/i-1", "arn:aws:ec2:eu-west-1:123456789012:subnet/subnet-1", RelationInSubnet},
+		{"arn:aws:ec2:eu-west-1:123456789012:instance/i-1", "arn:aws:ec2:eu-west-1:123456789012:vpc/vpc-1", RelationInVpc},
+		{"arn:aws:ec2:eu-west-1:123456789012:subnet/subnet-1", "arn:aws:ec2:eu-west-1:123456789012:vpc/vpc-1", RelationInVpc},
+		{"arn:aws:ec2:eu-west-1:123456789012:security-group/sg-1", "arn:aws:ec2:eu-west-1:123456789012:vpc/vpc-1", RelationInVpc},
+		{"arn:aws:iam::123456789012:instance-profile/web", "arn:aws:iam::123456789012:role/web", RelationHasRole},
+		{"arn:aws:iam::123456789012:role/web", "arn:aws:iam::123456789012:policy/web", RelationHasAttachedPolicy},
+	}, graph.Edges)
+}
@@ -0,0 +1,113 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/fatih/structs"
+)
+
+var (
+	GuardDutyService = Service{
+		Name: "guardduty",
+		Reports: map[string]Report{
+			"detectors": GDListDetectors,
+		},
+	}
+)
+
+func init() {
+	RegisterService(GuardDutyService)
+}
+
+func GDListDetectors(ctx context.Context, session *Session) *ReportResult {
+	client := session.GuardDutyClient()
+
+	result := &ReportResult{}
+	err := client.ListDetectorsPagesWithContext(ctx, &guardduty.ListDetectorsInput{},
+		func(page *guardduty.ListDetectorsOutput, lastPage bool) bool {
+			for _, detectorID := range page.DetectorIds {
+				detector, err := client.GetDetectorWithContext(ctx, &guardduty.GetDetectorInput{DetectorId: detectorID})
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+
+				resource := Resource{
+					ID: *detectorID,
+					ARN: BuildARN(session.Partition(), "guardduty",
+						*session.Config.Region,
+						session.AccountID,
+						fmt.Sprintf("detector/%s", *detectorID),
+					),
+					AccountID: session.AccountID,
+					Service:   "guardduty",
+					Type:      "detector",
+					Region:    *session.Config.Region,
+					Metadata:  structs.Map(detector),
+				}
+				resource.Metadata["Enabled"] = aws.StringValue(detector.Status) == guardduty.DetectorStatusEnabled
+
+				findings, err := GDFindingsBySeverity(ctx, client, *detectorID)
+				if err != nil {
+					result.AddError(err)
+					resource.Metadata["Error"] = err.Error()
+				} else {
+					resource.Metadata["FindingsBySeverity"] = findings
+				}
+
+				result.Resources = append(result.Resources, resource)
+			}
+			return true
+		})
+	result.AddError(err)
+
+	if err == nil && len(result.Resources) == 0 {
+		result.Resources = append(result.Resources, Resource{
+			ID:        session.AccountID,
+			AccountID: session.AccountID,
+			Service:   "guardduty",
+			Type:      "detector",
+			Region:    *session.Config.Region,
+			Metadata: map[string]interface{}{
+				"Enabled": false,
+			},
+		})
+	}
+	return result
+}
+
+// GDFindingsBySeverity counts the current findings using the severity bands
+// from the GuardDuty console.
+func GDFindingsBySeverity(ctx context.Context, client *guardduty.GuardDuty, detectorID string) (map[string]int64, error) {
+	statistics, err := client.GetFindingsStatisticsWithContext(ctx, &guardduty.GetFindingsStatisticsInput{
+		DetectorId:            aws.String(detectorID),
+		FindingStatisticTypes: aws.StringSlice([]string{guardduty.FindingStatisticTypeCountBySeverity}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[string]int64{"Low": 0, "Medium": 0, "High": 0}
+	if statistics.FindingStatistics == nil {
+		return counts, nil
+	}
+	for severity, count := range statistics.FindingStatistics.CountBySeverity {
+		value, err := strconv.ParseFloat(severity, 64)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case value >= 7:
+			counts["High"] += aws.Int64Value(count)
+		case value >= 4:
+			counts["Medium"] += aws.Int64Value(count)
+		default:
+			counts["Low"] += aws.Int64Value(count)
+		}
+	}
+	return counts, nil
+}
@@ -0,0 +1,33 @@
+package resources
+
+import (
+	"compress/gzip"
+	"io"
+)
+
+type GzipSink struct {
+	*NDJSONSink
+	gzip *gzip.Writer
+}
+
+func NewGzipSink(w io.Writer) *GzipSink {
+	gz := gzip.NewWriter(w)
+	sink := NewNDJSONSink(gz)
+	// flushing the gzip writer ends a deflate block, doing it for each
+	// resource would defeat the compression
+	sink.flushOnEmit = false
+	return &GzipSink{
+		NDJSONSink: sink,
+		gzip:       gz,
+	}
+}
+
+// Close flushes any buffered data and writes the gzip footer, it must be
+// called once all the resources have been emitted. The underlying writer
+// is left open.
+func (s *GzipSink) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.gzip.Close()
+}
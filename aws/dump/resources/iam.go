@@ -1,12 +1,21 @@
 package resources
 
 import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
 	"github.com/fatih/structs"
+	"github.com/hamstah/awstools/common"
 )
 
 var (
@@ -20,15 +29,26 @@ var (
 			"groups":                        IAMListGroups,
 			"instance-profiles":             IAMListInstanceProfiles,
 			"account-authorization-details": IAMListAccountAuthorizationDetails,
+			"credential-report":             IAMGetCredentialReport,
+			"password-policy":               IAMGetAccountPasswordPolicy,
+			"account-summary":               IAMGetAccountSummary,
+			"stale-access-keys":             IAMListStaleAccessKeys,
+			"risky-policies":                IAMListRiskyPolicies,
+			"saml-providers":                IAMListSAMLProviders,
+			"oidc-providers":                IAMListOpenIDConnectProviders,
 		},
 	}
 )
 
-type PolicyFetchFunc func(*Session, *iam.IAM, string, string) *ReportResult
+func init() {
+	RegisterService(IAMService)
+}
+
+type PolicyFetchFunc func(context.Context, *Session, iamiface.IAMAPI, string, string) *ReportResult
 
-func IAMListUserAttachedPolicies(session *Session, client *iam.IAM, userARN, userName string) *ReportResult {
+func IAMListUserAttachedPolicies(ctx context.Context, session *Session, client iamiface.IAMAPI, userARN, userName string) *ReportResult {
 	result := &ReportResult{}
-	err := client.ListAttachedUserPoliciesPages(&iam.ListAttachedUserPoliciesInput{UserName: aws.String(userName)},
+	err := client.ListAttachedUserPoliciesPagesWithContext(ctx, &iam.ListAttachedUserPoliciesInput{UserName: aws.String(userName)},
 		func(page *iam.ListAttachedUserPoliciesOutput, lastPage bool) bool {
 			for _, policy := range page.AttachedPolicies {
 				r := Resource{
@@ -49,16 +69,28 @@ func IAMListUserAttachedPolicies(session *Session, client *iam.IAM, userARN, use
 	return result
 }
 
-func IAMListUserPolicies(session *Session, client *iam.IAM, userARN, userName string) *ReportResult {
+func IAMListUserPolicies(ctx context.Context, session *Session, client iamiface.IAMAPI, userARN, userName string) *ReportResult {
 	result := &ReportResult{}
-	err := client.ListUserPoliciesPages(&iam.ListUserPoliciesInput{UserName: aws.String(userName)},
+	err := client.ListUserPoliciesPagesWithContext(ctx, &iam.ListUserPoliciesInput{UserName: aws.String(userName)},
 		func(page *iam.ListUserPoliciesOutput, lastPage bool) bool {
 			for _, policyName := range page.PolicyNames {
 
-				policy, err := client.GetUserPolicy(&iam.GetUserPolicyInput{UserName: aws.String(userName), PolicyName: policyName})
+				policy, err := client.GetUserPolicyWithContext(ctx, &iam.GetUserPolicyInput{UserName: aws.String(userName), PolicyName: policyName})
 				if err != nil {
-					result.Error = err
-					return false
+					result.AddError(fmt.Errorf("failed to get policy %s of user %s: %w", *policyName, userName, err))
+					result.Resources = append(result.Resources, Resource{
+						ID:        fmt.Sprintf("%s_%s_inline", userName, *policyName),
+						AccountID: session.AccountID,
+						Service:   "iam",
+						Type:      "user-policy-inline",
+						Region:    *session.Config.Region,
+						Metadata: map[string]interface{}{
+							"PolicyName": *policyName,
+							"UserArn":    userARN,
+							"Error":      err.Error(),
+						},
+					})
+					continue
 				}
 
 				r := Resource{
@@ -72,76 +104,213 @@ func IAMListUserPolicies(session *Session, client *iam.IAM, userARN, userName st
 				}
 				document, err := DecodeInlinePolicyDocument(*r.Metadata["PolicyDocument"].(*string))
 				if err != nil {
-					result.Error = err
-					return false
+					result.AddError(err)
+					r.Metadata["Error"] = err.Error()
+				} else {
+					r.Metadata["PolicyDocument"] = document
 				}
-				r.Metadata["PolicyDocument"] = document
 				r.Metadata["UserArn"] = userARN
 				result.Resources = append(result.Resources, r)
 			}
 			return true
 		})
-	result.Error = err
+	result.AddError(err)
 	return result
 }
 
-func IAMListUsersAndAccessKeys(session *Session) *ReportResult {
+func IAMListUsersAndAccessKeys(ctx context.Context, session *Session) *ReportResult {
 
 	policiesFunctions := []PolicyFetchFunc{IAMListUserPolicies, IAMListUserAttachedPolicies}
 
-	client := iam.New(session.Session, session.Config)
+	client := session.IAMClient()
 	accessKeys := []Resource{}
 	arns := []*string{}
+	targets := []int{}
+	limiter := session.pageLimiter()
 	result := &ReportResult{}
-	result.Error = client.ListUsersPages(&iam.ListUsersInput{},
+	err := client.ListUsersPagesWithContext(ctx, &iam.ListUsersInput{},
 		func(page *iam.ListUsersOutput, lastPage bool) bool {
 			for _, user := range page.Users {
+				if !limiter.Item() {
+					return false
+				}
+
 				resource, err := NewResource(*user.Arn, user)
 				if err != nil {
-					result.Error = err
-					return false
+					result.AddError(err)
+					continue
+				}
+
+				mfaDevices, err := IAMListMFADevices(ctx, client, *user.UserName)
+				if err != nil {
+					result.AddError(err)
+					resource.Metadata["MFADevicesError"] = err.Error()
+				} else {
+					resource.Metadata["MFADevices"] = mfaDevices
+					resource.Metadata["MFAEnabled"] = len(mfaDevices) > 0
+				}
+
+				sshPublicKeys, err := IAMListSSHPublicKeys(ctx, client, *user.UserName)
+				if err != nil {
+					result.AddError(err)
+					resource.Metadata["SSHPublicKeysError"] = err.Error()
+				} else {
+					resource.Metadata["SSHPublicKeys"] = sshPublicKeys
+				}
+
+				serviceSpecificCredentials, err := IAMListServiceSpecificCredentials(ctx, client, *user.UserName)
+				if err != nil {
+					result.AddError(err)
+					resource.Metadata["ServiceSpecificCredentialsError"] = err.Error()
+				} else {
+					resource.Metadata["ServiceSpecificCredentials"] = serviceSpecificCredentials
+				}
+
+				signingCertificates, err := IAMListSigningCertificates(ctx, client, *user.UserName)
+				if err != nil {
+					result.AddError(err)
+					resource.Metadata["SigningCertificatesError"] = err.Error()
+				} else {
+					resource.Metadata["SigningCertificates"] = signingCertificates
+					hasActive := false
+					for _, certificate := range signingCertificates {
+						if certificate["Status"] == iam.StatusTypeActive {
+							hasActive = true
+						}
+					}
+					resource.Metadata["HasActiveSigningCertificate"] = hasActive
 				}
+
 				arns = append(arns, user.Arn)
+				targets = append(targets, len(result.Resources))
 				result.Resources = append(result.Resources, *resource)
 
 				for _, fn := range policiesFunctions {
-					policies := fn(session, client, *user.Arn, *user.UserName)
-					if policies.Error != nil {
-						result.Error = policies.Error
+					policies := fn(ctx, session, client, *user.Arn, *user.UserName)
+					result.AddError(policies.Error)
+					result.Resources = append(result.Resources, policies.Resources...)
+					if err := session.Emit(policies.Resources...); err != nil {
+						result.AddError(err)
 						return false
 					}
-					result.Resources = append(result.Resources, policies.Resources...)
 				}
 
-				keysResult := IAMListAccessKeys(session, client, *user.UserName)
-				if keysResult.Error != nil {
-					result.Error = keysResult.Error
+				keysResult := IAMListAccessKeys(ctx, session, client, *user.UserName)
+				result.AddError(keysResult.Error)
+				accessKeys = append(accessKeys, keysResult.Resources...)
+				if err := session.Emit(keysResult.Resources...); err != nil {
+					result.AddError(err)
 					return false
 				}
-				accessKeys = append(accessKeys, keysResult.Resources...)
+				// users take several calls each, report progress per user
+				session.progress(len(result.Resources) + len(accessKeys))
+			}
+
+			return limiter.Page(lastPage)
+		})
+	result.AddError(err)
+	result.Truncated = limiter.truncated
+
+	attachLastAccessed(ctx, session, client, result, arns, targets)
+	result.AddError(emitTargets(session, result, targets))
+	result.Resources = append(result.Resources, accessKeys...)
+	return result
+}
+
+func MFADeviceType(serialNumber string) string {
+	if !strings.HasPrefix(serialNumber, "arn:") {
+		return "hardware"
+	}
+
+	parsed, err := common.ParseARN(serialNumber)
+	if err == nil && parsed.ResourceType == "u2f" {
+		return "u2f"
+	}
+	return "virtual"
+}
+
+func IAMListMFADevices(ctx context.Context, client iamiface.IAMAPI, username string) ([]map[string]interface{}, error) {
+	devices := []map[string]interface{}{}
+	err := client.ListMFADevicesPagesWithContext(ctx, &iam.ListMFADevicesInput{UserName: aws.String(username)},
+		func(page *iam.ListMFADevicesOutput, lastPage bool) bool {
+			for _, device := range page.MFADevices {
+				metadata := structs.Map(device)
+				metadata["Type"] = MFADeviceType(*device.SerialNumber)
+				devices = append(devices, metadata)
 			}
+			return true
+		})
+	if err != nil {
+		return nil, err
+	}
+	return devices, nil
+}
 
+func credentialAgeDays(created *time.Time) int {
+	if created == nil {
+		return 0
+	}
+	return int(time.Since(*created).Hours() / 24)
+}
+
+func IAMListSSHPublicKeys(ctx context.Context, client iamiface.IAMAPI, username string) ([]map[string]interface{}, error) {
+	keys := []map[string]interface{}{}
+	err := client.ListSSHPublicKeysPagesWithContext(ctx, &iam.ListSSHPublicKeysInput{UserName: aws.String(username)},
+		func(page *iam.ListSSHPublicKeysOutput, lastPage bool) bool {
+			for _, key := range page.SSHPublicKeys {
+				metadata := structs.Map(key)
+				metadata["AgeDays"] = credentialAgeDays(key.UploadDate)
+				keys = append(keys, metadata)
+			}
 			return true
 		})
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
 
-	if result.Error != nil {
-		return result
+// Only the certificate metadata is kept, the certificate body is dropped.
+func IAMListSigningCertificates(ctx context.Context, client iamiface.IAMAPI, username string) ([]map[string]interface{}, error) {
+	certificates := []map[string]interface{}{}
+	err := client.ListSigningCertificatesPagesWithContext(ctx, &iam.ListSigningCertificatesInput{UserName: aws.String(username)},
+		func(page *iam.ListSigningCertificatesOutput, lastPage bool) bool {
+			for _, certificate := range page.Certificates {
+				certificates = append(certificates, map[string]interface{}{
+					"CertificateId": aws.StringValue(certificate.CertificateId),
+					"Status":        aws.StringValue(certificate.Status),
+					"UploadDate":    certificate.UploadDate,
+					"AgeDays":       credentialAgeDays(certificate.UploadDate),
+				})
+			}
+			return true
+		})
+	if err != nil {
+		return nil, err
 	}
+	return certificates, nil
+}
 
-	jobIds, err := GenerateServiceLastAccessedDetails(client, arns)
+func IAMListServiceSpecificCredentials(ctx context.Context, client iamiface.IAMAPI, username string) ([]map[string]interface{}, error) {
+	res, err := client.ListServiceSpecificCredentialsWithContext(ctx, &iam.ListServiceSpecificCredentialsInput{
+		UserName: aws.String(username),
+	})
 	if err != nil {
-		result.Error = err
-		return result
+		return nil, err
 	}
-	AttachServiceLastAccessedDetails(client, result, jobIds)
 
-	result.Resources = append(result.Resources, accessKeys...)
-	return result
+	credentials := []map[string]interface{}{}
+	for _, credential := range res.ServiceSpecificCredentials {
+		metadata := structs.Map(credential)
+		metadata["AgeDays"] = credentialAgeDays(credential.CreateDate)
+		credentials = append(credentials, metadata)
+	}
+	return credentials, nil
 }
 
-func IAMListGroupAttachedPolicies(session *Session, client *iam.IAM, groupARN, groupName string) *ReportResult {
+func IAMListGroupAttachedPolicies(ctx context.Context, session *Session, client iamiface.IAMAPI, groupARN, groupName string) *ReportResult {
 	result := &ReportResult{}
-	err := client.ListAttachedGroupPoliciesPages(&iam.ListAttachedGroupPoliciesInput{GroupName: aws.String(groupName)},
+	err := client.ListAttachedGroupPoliciesPagesWithContext(ctx, &iam.ListAttachedGroupPoliciesInput{GroupName: aws.String(groupName)},
 		func(page *iam.ListAttachedGroupPoliciesOutput, lastPage bool) bool {
 			for _, policy := range page.AttachedPolicies {
 				r := Resource{
@@ -162,16 +331,28 @@ func IAMListGroupAttachedPolicies(session *Session, client *iam.IAM, groupARN, g
 	return result
 }
 
-func IAMListGroupPolicies(session *Session, client *iam.IAM, groupARN, groupName string) *ReportResult {
+func IAMListGroupPolicies(ctx context.Context, session *Session, client iamiface.IAMAPI, groupARN, groupName string) *ReportResult {
 	result := &ReportResult{}
-	err := client.ListGroupPoliciesPages(&iam.ListGroupPoliciesInput{GroupName: aws.String(groupName)},
+	err := client.ListGroupPoliciesPagesWithContext(ctx, &iam.ListGroupPoliciesInput{GroupName: aws.String(groupName)},
 		func(page *iam.ListGroupPoliciesOutput, lastPage bool) bool {
 			for _, policyName := range page.PolicyNames {
 
-				policy, err := client.GetGroupPolicy(&iam.GetGroupPolicyInput{GroupName: aws.String(groupName), PolicyName: policyName})
+				policy, err := client.GetGroupPolicyWithContext(ctx, &iam.GetGroupPolicyInput{GroupName: aws.String(groupName), PolicyName: policyName})
 				if err != nil {
-					result.Error = err
-					return false
+					result.AddError(fmt.Errorf("failed to get policy %s of group %s: %w", *policyName, groupName, err))
+					result.Resources = append(result.Resources, Resource{
+						ID:        fmt.Sprintf("%s_%s_inline", groupName, *policyName),
+						AccountID: session.AccountID,
+						Service:   "iam",
+						Type:      "group-policy-inline",
+						Region:    *session.Config.Region,
+						Metadata: map[string]interface{}{
+							"PolicyName": *policyName,
+							"GroupArn":   groupARN,
+							"Error":      err.Error(),
+						},
+					})
+					continue
 				}
 
 				r := Resource{
@@ -185,71 +366,87 @@ func IAMListGroupPolicies(session *Session, client *iam.IAM, groupARN, groupName
 				}
 				document, err := DecodeInlinePolicyDocument(*r.Metadata["PolicyDocument"].(*string))
 				if err != nil {
-					result.Error = err
-					return false
+					result.AddError(err)
+					r.Metadata["Error"] = err.Error()
+				} else {
+					r.Metadata["PolicyDocument"] = document
 				}
-				r.Metadata["PolicyDocument"] = document
 				r.Metadata["GroupArn"] = groupARN
 				result.Resources = append(result.Resources, r)
 			}
 			return true
 		})
-	result.Error = err
+	result.AddError(err)
 	return result
 }
 
-func IAMListGroups(session *Session) *ReportResult {
+func IAMListGroupMembers(ctx context.Context, client iamiface.IAMAPI, groupName string) ([]string, error) {
+	members := []string{}
+	err := client.GetGroupPagesWithContext(ctx, &iam.GetGroupInput{GroupName: aws.String(groupName)},
+		func(page *iam.GetGroupOutput, lastPage bool) bool {
+			for _, user := range page.Users {
+				members = append(members, *user.UserName)
+			}
+			return true
+		})
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+func IAMListGroups(ctx context.Context, session *Session) *ReportResult {
 
 	policiesFunctions := []PolicyFetchFunc{IAMListGroupPolicies, IAMListGroupAttachedPolicies}
 
-	client := iam.New(session.Session, session.Config)
+	client := session.IAMClient()
 	arns := []*string{}
+	targets := []int{}
 	result := &ReportResult{}
-	result.Error = client.ListGroupsPages(&iam.ListGroupsInput{},
+	err := client.ListGroupsPagesWithContext(ctx, &iam.ListGroupsInput{},
 		func(page *iam.ListGroupsOutput, lastPage bool) bool {
 			for _, group := range page.Groups {
 
 				resource, err := NewResource(*group.Arn, group)
 				if err != nil {
-					result.Error = err
-					return false
+					result.AddError(err)
+					continue
 				}
+
+				members, err := IAMListGroupMembers(ctx, client, *group.GroupName)
+				if err != nil {
+					result.AddError(err)
+					resource.Metadata["Error"] = err.Error()
+				} else {
+					resource.Metadata["Members"] = members
+				}
+
 				arns = append(arns, group.Arn)
+				targets = append(targets, len(result.Resources))
 				result.Resources = append(result.Resources, *resource)
 
 				for _, fn := range policiesFunctions {
-					policies := fn(session, client, *group.Arn, *group.GroupName)
-					if policies.Error != nil {
-						result.Error = policies.Error
-						return false
-					}
+					policies := fn(ctx, session, client, *group.Arn, *group.GroupName)
+					result.AddError(policies.Error)
 					result.Resources = append(result.Resources, policies.Resources...)
 				}
 			}
 
 			return true
 		})
+	result.AddError(err)
 
-	if result.Error != nil {
-		return result
-	}
-
-	jobIds, err := GenerateServiceLastAccessedDetails(client, arns)
-	if err != nil {
-		result.Error = err
-		return result
-	}
-	AttachServiceLastAccessedDetails(client, result, jobIds)
+	attachLastAccessed(ctx, session, client, result, arns, targets)
 
 	return result
 }
 
-func IAMListAccountAuthorizationDetails(session *Session) *ReportResult {
-	client := iam.New(session.Session, session.Config)
+func IAMListAccountAuthorizationDetails(ctx context.Context, session *Session) *ReportResult {
+	client := session.IAMClient()
 
 	result := &ReportResult{}
 
-	err := client.GetAccountAuthorizationDetailsPages(&iam.GetAccountAuthorizationDetailsInput{},
+	err := client.GetAccountAuthorizationDetailsPagesWithContext(ctx, &iam.GetAccountAuthorizationDetailsInput{},
 		func(page *iam.GetAccountAuthorizationDetailsOutput, lastPage bool) bool {
 
 			for _, group := range page.GroupDetailList {
@@ -267,8 +464,9 @@ func IAMListAccountAuthorizationDetails(session *Session) *ReportResult {
 
 					document, err := DecodeInlinePolicyDocument(*policy["PolicyDocument"].(*string))
 					if err != nil {
-						result.Error = err
-						return false
+						result.AddError(err)
+						resource.Metadata["Error"] = err.Error()
+						continue
 					}
 					policy["PolicyDocument"] = document
 				}
@@ -291,8 +489,9 @@ func IAMListAccountAuthorizationDetails(session *Session) *ReportResult {
 
 					document, err := DecodeInlinePolicyDocument(*policy["PolicyDocument"].(*string))
 					if err != nil {
-						result.Error = err
-						return false
+						result.AddError(err)
+						resource.Metadata["Error"] = err.Error()
+						continue
 					}
 					policy["PolicyDocument"] = document
 				}
@@ -312,10 +511,11 @@ func IAMListAccountAuthorizationDetails(session *Session) *ReportResult {
 
 				document, err := DecodeInlinePolicyDocument(*resource.Metadata["AssumeRolePolicyDocument"].(*string))
 				if err != nil {
-					result.Error = err
-					return false
+					result.AddError(err)
+					resource.Metadata["Error"] = err.Error()
+				} else {
+					resource.Metadata["AssumeRolePolicyDocument"] = document
 				}
-				resource.Metadata["AssumeRolePolicyDocument"] = document
 
 				for _, instanceProfileI := range resource.Metadata["InstanceProfileList"].([]interface{}) {
 					instanceProfile := instanceProfileI.(map[string]interface{})
@@ -323,8 +523,9 @@ func IAMListAccountAuthorizationDetails(session *Session) *ReportResult {
 						role := roleI.(map[string]interface{})
 						document, err := DecodeInlinePolicyDocument(*role["AssumeRolePolicyDocument"].(*string))
 						if err != nil {
-							result.Error = err
-							return false
+							result.AddError(err)
+							resource.Metadata["Error"] = err.Error()
+							continue
 						}
 						role["AssumeRolePolicyDocument"] = document
 					}
@@ -348,8 +549,9 @@ func IAMListAccountAuthorizationDetails(session *Session) *ReportResult {
 
 					document, err := DecodeInlinePolicyDocument(*policy["Document"].(*string))
 					if err != nil {
-						result.Error = err
-						return false
+						result.AddError(err)
+						resource.Metadata["Error"] = err.Error()
+						continue
 					}
 					policy["Document"] = document
 				}
@@ -360,13 +562,13 @@ func IAMListAccountAuthorizationDetails(session *Session) *ReportResult {
 			return true
 		})
 
-	result.Error = err
+	result.AddError(err)
 	return result
 }
 
-func IAMListRoleAttachedPolicies(session *Session, client *iam.IAM, roleARN, roleName string) *ReportResult {
+func IAMListRoleAttachedPolicies(ctx context.Context, session *Session, client iamiface.IAMAPI, roleARN, roleName string) *ReportResult {
 	result := &ReportResult{}
-	err := client.ListAttachedRolePoliciesPages(&iam.ListAttachedRolePoliciesInput{RoleName: aws.String(roleName)},
+	err := client.ListAttachedRolePoliciesPagesWithContext(ctx, &iam.ListAttachedRolePoliciesInput{RoleName: aws.String(roleName)},
 		func(page *iam.ListAttachedRolePoliciesOutput, lastPage bool) bool {
 			for _, policy := range page.AttachedPolicies {
 				r := Resource{
@@ -387,16 +589,28 @@ func IAMListRoleAttachedPolicies(session *Session, client *iam.IAM, roleARN, rol
 	return result
 }
 
-func IAMListRolePolicies(session *Session, client *iam.IAM, roleARN, roleName string) *ReportResult {
+func IAMListRolePolicies(ctx context.Context, session *Session, client iamiface.IAMAPI, roleARN, roleName string) *ReportResult {
 	result := &ReportResult{}
-	err := client.ListRolePoliciesPages(&iam.ListRolePoliciesInput{RoleName: aws.String(roleName)},
+	err := client.ListRolePoliciesPagesWithContext(ctx, &iam.ListRolePoliciesInput{RoleName: aws.String(roleName)},
 		func(page *iam.ListRolePoliciesOutput, lastPage bool) bool {
 			for _, policyName := range page.PolicyNames {
 
-				policy, err := client.GetRolePolicy(&iam.GetRolePolicyInput{RoleName: aws.String(roleName), PolicyName: policyName})
+				policy, err := client.GetRolePolicyWithContext(ctx, &iam.GetRolePolicyInput{RoleName: aws.String(roleName), PolicyName: policyName})
 				if err != nil {
-					result.Error = err
-					return false
+					result.AddError(fmt.Errorf("failed to get policy %s of role %s: %w", *policyName, roleName, err))
+					result.Resources = append(result.Resources, Resource{
+						ID:        fmt.Sprintf("%s_%s_inline", roleName, *policyName),
+						AccountID: session.AccountID,
+						Service:   "iam",
+						Type:      "role-policy-inline",
+						Region:    *session.Config.Region,
+						Metadata: map[string]interface{}{
+							"PolicyName": *policyName,
+							"RoleArn":    roleARN,
+							"Error":      err.Error(),
+						},
+					})
+					continue
 				}
 
 				r := Resource{
@@ -410,101 +624,126 @@ func IAMListRolePolicies(session *Session, client *iam.IAM, roleARN, roleName st
 				}
 				document, err := DecodeInlinePolicyDocument(*r.Metadata["PolicyDocument"].(*string))
 				if err != nil {
-					result.Error = err
-					return false
+					result.AddError(err)
+					r.Metadata["Error"] = err.Error()
+				} else {
+					r.Metadata["PolicyDocument"] = document
 				}
-				r.Metadata["PolicyDocument"] = document
 				r.Metadata["RoleArn"] = roleARN
 				result.Resources = append(result.Resources, r)
 			}
 			return true
 		})
-	result.Error = err
+	result.AddError(err)
 	return result
 }
 
-func IAMListRoles(session *Session) *ReportResult {
+func IAMListRoles(ctx context.Context, session *Session) *ReportResult {
 
 	policiesFunctions := []PolicyFetchFunc{IAMListRolePolicies, IAMListRoleAttachedPolicies}
 
-	client := iam.New(session.Session, session.Config)
+	client := session.IAMClient()
 	arns := []*string{}
+	targets := []int{}
 	result := &ReportResult{}
-	result.Error = client.ListRolesPages(&iam.ListRolesInput{},
+	err := client.ListRolesPagesWithContext(ctx, &iam.ListRolesInput{},
 		func(page *iam.ListRolesOutput, lastPage bool) bool {
 			for _, role := range page.Roles {
 				resource, err := NewResource(*role.Arn, role)
 				if err != nil {
-					result.Error = err
-					return false
+					result.AddError(err)
+					continue
 				}
 
 				document, err := DecodeInlinePolicyDocument(*resource.Metadata["AssumeRolePolicyDocument"].(*string))
 				if err != nil {
-					result.Error = err
-					return false
+					result.AddError(err)
+					resource.Metadata["Error"] = err.Error()
+				} else {
+					resource.Metadata["AssumeRolePolicyDocument"] = document
 				}
-				resource.Metadata["AssumeRolePolicyDocument"] = document
 
 				resource.ID = *role.RoleId
-				arns = append(arns, role.Arn)
-				result.Resources = append(result.Resources, *resource)
 
-				policies := IAMListRolePolicies(session, client, *role.Arn, *role.RoleName)
-				if policies.Error != nil {
-					result.Error = policies.Error
-					return false
+				rolePolicies := []Resource{}
+				for _, fn := range policiesFunctions {
+					policies := fn(ctx, session, client, *role.Arn, *role.RoleName)
+					result.AddError(policies.Error)
+					rolePolicies = append(rolePolicies, policies.Resources...)
 				}
-				result.Resources = append(result.Resources, policies.Resources...)
 
-				for _, fn := range policiesFunctions {
-					policies := fn(session, client, *role.Arn, *role.RoleName)
-					if policies.Error != nil {
-						result.Error = policies.Error
-						return false
+				attachedPolicies := []map[string]interface{}{}
+				inlinePolicies := []map[string]interface{}{}
+				for _, policy := range rolePolicies {
+					switch policy.Type {
+					case "role-policy-attachment":
+						attachedPolicies = append(attachedPolicies, map[string]interface{}{
+							"PolicyArn":  policy.Metadata["PolicyArn"],
+							"PolicyName": policy.Metadata["PolicyName"],
+						})
+					case "role-policy-inline":
+						inlinePolicies = append(inlinePolicies, map[string]interface{}{
+							"PolicyName":     policy.Metadata["PolicyName"],
+							"PolicyDocument": policy.Metadata["PolicyDocument"],
+						})
 					}
-					result.Resources = append(result.Resources, policies.Resources...)
+				}
+				resource.Metadata["AttachedPolicies"] = attachedPolicies
+				resource.Metadata["InlinePolicies"] = inlinePolicies
+
+				arns = append(arns, role.Arn)
+				targets = append(targets, len(result.Resources))
+				result.Resources = append(result.Resources, *resource)
+				result.Resources = append(result.Resources, rolePolicies...)
+				if err := session.Emit(rolePolicies...); err != nil {
+					result.AddError(err)
+					return false
 				}
 			}
 
 			return true
 		})
+	result.AddError(err)
 
-	if result.Error != nil {
-		return result
-	}
+	attachLastAccessed(ctx, session, client, result, arns, targets)
+	result.AddError(emitTargets(session, result, targets))
+	return result
+}
 
-	jobIds, err := GenerateServiceLastAccessedDetails(client, arns)
-	if err != nil {
-		result.Error = err
-		return result
+// Resources waiting on last accessed details are only emitted once those
+// details have been attached.
+func emitTargets(session *Session, result *ReportResult, targets []int) error {
+	for _, i := range targets {
+		err := session.Emit(result.Resources[i])
+		if err != nil {
+			return err
+		}
 	}
-	AttachServiceLastAccessedDetails(client, result, jobIds)
-
-	return result
+	return nil
 }
 
-func IAMListPolicyVersions(session *Session, client *iam.IAM, policyArn string) *ReportResult {
+func IAMListPolicyVersions(ctx context.Context, session *Session, client iamiface.IAMAPI, policyArn string) *ReportResult {
 	result := &ReportResult{}
-	err := client.ListPolicyVersionsPages(&iam.ListPolicyVersionsInput{PolicyArn: aws.String(policyArn)},
+	err := client.ListPolicyVersionsPagesWithContext(ctx, &iam.ListPolicyVersionsInput{PolicyArn: aws.String(policyArn)},
 		func(page *iam.ListPolicyVersionsOutput, lastPage bool) bool {
 			for _, resource := range page.Versions {
-
-				policyVersion, err := client.GetPolicyVersion(&iam.GetPolicyVersionInput{PolicyArn: aws.String(policyArn), VersionId: resource.VersionId})
+				var metadata map[string]interface{}
+				policyVersion, err := client.GetPolicyVersionWithContext(ctx, &iam.GetPolicyVersionInput{PolicyArn: aws.String(policyArn), VersionId: resource.VersionId})
 				if err != nil {
-					result.Error = err
-					return false
-				}
-
-				document, err := DecodeInlinePolicyDocument(*policyVersion.PolicyVersion.Document)
-				if err != nil {
-					result.Error = err
-					return false
+					result.AddError(err)
+					metadata = structs.Map(resource)
+					metadata["Error"] = err.Error()
+				} else {
+					metadata = structs.Map(policyVersion.PolicyVersion)
+					document, err := DecodeInlinePolicyDocument(*policyVersion.PolicyVersion.Document)
+					if err != nil {
+						result.AddError(err)
+						metadata["Error"] = err.Error()
+					} else {
+						metadata["Document"] = document
+					}
 				}
 
-				metadata := structs.Map(policyVersion.PolicyVersion)
-				metadata["Document"] = document
-
 				arn := fmt.Sprintf("%s:%s", policyArn, *resource.VersionId)
 				r := Resource{
 					ID:        arn,
@@ -520,136 +759,342 @@ func IAMListPolicyVersions(session *Session, client *iam.IAM, policyArn string)
 			return true
 		})
 
-	if result.Error != nil {
-		return result
-	}
-
-	result.Error = err
+	result.AddError(err)
 	return result
 }
 
-func IAMListPolicies(session *Session) *ReportResult {
-	client := iam.New(session.Session, session.Config)
+func IAMListPolicies(ctx context.Context, session *Session) *ReportResult {
+	return iamListPolicies(ctx, session, session.IAMClient())
+}
+
+func iamListPolicies(ctx context.Context, session *Session, client iamiface.IAMAPI) *ReportResult {
 	arns := []*string{}
+	targets := []int{}
+	options := session.options()
+	scope := options.PolicyScope
+	if scope == "" {
+		scope = iam.PolicyScopeTypeLocal
+	}
+
 	result := &ReportResult{}
-	result.Error = client.ListPoliciesPages(&iam.ListPoliciesInput{Scope: aws.String("Local")},
+	err := client.ListPoliciesPagesWithContext(ctx, &iam.ListPoliciesInput{Scope: aws.String(scope)},
 		func(page *iam.ListPoliciesOutput, lastPage bool) bool {
 			for _, policy := range page.Policies {
+				// there are over a thousand AWS managed policies, only keep
+				// the ones in use when asked to
+				if options.PolicyReferencedOnly && isAWSManagedPolicy(*policy.Arn) && aws.Int64Value(policy.AttachmentCount) == 0 {
+					continue
+				}
+
 				resource, err := NewResource(*policy.Arn, policy)
 				if err != nil {
-					result.Error = err
-					return false
+					result.AddError(err)
+					continue
 				}
 
 				arns = append(arns, policy.Arn)
 
-				policyVersions := IAMListPolicyVersions(session, client, *policy.Arn)
-				if policyVersions.Error != nil {
-					result.Error = policyVersions.Error
-					return false
-				}
+				policyVersions := IAMListPolicyVersions(ctx, session, client, *policy.Arn)
+				result.AddError(policyVersions.Error)
 
+				targets = append(targets, len(result.Resources))
 				result.Resources = append(result.Resources, *resource)
 				result.Resources = append(result.Resources, policyVersions.Resources...)
 			}
 
 			return true
 		})
+	result.AddError(err)
 
-	if result.Error != nil {
-		return result
-	}
-
-	jobIds, err := GenerateServiceLastAccessedDetails(client, arns)
-	if err != nil {
-		result.Error = err
-		return result
-	}
-	AttachServiceLastAccessedDetails(client, result, jobIds)
+	attachLastAccessed(ctx, session, client, result, arns, targets)
 	return result
 }
 
-func IAMListAccessKeys(session *Session, client *iam.IAM, username string) *ReportResult {
+func isAWSManagedPolicy(arn string) bool {
+	return strings.Contains(arn, ":iam::aws:policy/")
+}
+
+// Access keys don't have an ARN, this builds a stable one from the owning user.
+func IAMAccessKeyARN(partition, accountID, username, accessKeyID string) string {
+	return BuildARN(partition, "iam", "", accountID, fmt.Sprintf("user/%s/accesskey/%s", username, accessKeyID))
+}
+
+func IAMListAccessKeys(ctx context.Context, session *Session, client iamiface.IAMAPI, username string) *ReportResult {
 	result := &ReportResult{}
-	result.Error = client.ListAccessKeysPages(&iam.ListAccessKeysInput{
+	err := client.ListAccessKeysPagesWithContext(ctx, &iam.ListAccessKeysInput{
 		UserName: aws.String(username),
 	},
 		func(page *iam.ListAccessKeysOutput, lastPage bool) bool {
 			for _, accessKey := range page.AccessKeyMetadata {
 				resource := Resource{
 					ID:        *accessKey.AccessKeyId,
+					ARN:       IAMAccessKeyARN(session.Partition(), session.AccountID, username, *accessKey.AccessKeyId),
 					AccountID: session.AccountID,
 					Service:   "iam",
 					Type:      "access-key",
+					Region:    GlobalRegion(session.Partition()),
 					Metadata:  structs.Map(accessKey),
 				}
 
-				lastUsed, err := client.GetAccessKeyLastUsed(&iam.GetAccessKeyLastUsedInput{AccessKeyId: accessKey.AccessKeyId})
+				lastUsed, err := client.GetAccessKeyLastUsedWithContext(ctx, &iam.GetAccessKeyLastUsedInput{AccessKeyId: accessKey.AccessKeyId})
 				if err != nil {
-					result.Error = err
-					return false
+					result.AddError(fmt.Errorf("failed to get last used of access key %s: %w", *accessKey.AccessKeyId, err))
+					resource.Metadata["Error"] = err.Error()
+				} else {
+					resource.Metadata["AccessKeyLastUsed"] = structs.Map(lastUsed.AccessKeyLastUsed)
+					resource.Metadata["LastUsed"] = lastUsed.AccessKeyLastUsed.LastUsedDate
 				}
-				resource.Metadata["AccessKeyLastUsed"] = structs.Map(lastUsed.AccessKeyLastUsed)
-				resource.Metadata["LastUsed"] = lastUsed.AccessKeyLastUsed.LastUsedDate
 				result.Resources = append(result.Resources, resource)
 			}
 
 			return true
 		})
 
+	result.AddError(err)
 	return result
 }
 
-func GenerateServiceLastAccessedDetails(client *iam.IAM, arns []*string) ([]*string, error) {
-	jobIds := []*string{}
-	for _, arn := range arns {
-		job, err := client.GenerateServiceLastAccessedDetails(&iam.GenerateServiceLastAccessedDetailsInput{
-			Arn: arn,
+func IAMListStaleAccessKeys(ctx context.Context, session *Session) *ReportResult {
+	client := session.IAMClient()
+	threshold := session.options().StaleAccessKeyThreshold
+	now := time.Now()
+
+	result := &ReportResult{}
+	err := client.ListUsersPagesWithContext(ctx, &iam.ListUsersInput{},
+		func(page *iam.ListUsersOutput, lastPage bool) bool {
+			for _, user := range page.Users {
+				keysResult := IAMListAccessKeys(ctx, session, client, *user.UserName)
+				result.AddError(keysResult.Error)
+				for _, resource := range keysResult.Resources {
+					finding := staleAccessKeyFinding(resource, threshold, now)
+					if finding == "" {
+						continue
+					}
+					resource.Metadata["Finding"] = finding
+					result.Resources = append(result.Resources, resource)
+				}
+			}
+			return true
 		})
-		if err != nil {
-			return nil, err
-		}
-		jobIds = append(jobIds, job.JobId)
+
+	result.AddError(err)
+	return result
+}
+
+const (
+	AccessKeyFindingInactive  = "inactive"
+	AccessKeyFindingNeverUsed = "never-used"
+	AccessKeyFindingStale     = "stale"
+)
+
+func staleAccessKeyFinding(resource Resource, threshold time.Duration, now time.Time) string {
+	if status, _ := NormalizeValue(resource.Metadata["Status"]).(string); status == iam.StatusTypeInactive {
+		return AccessKeyFindingInactive
+	}
+	if _, ok := resource.Metadata["Error"]; ok {
+		return ""
+	}
+
+	lastUsed, _ := resource.Metadata["LastUsed"].(*time.Time)
+	if lastUsed == nil {
+		return AccessKeyFindingNeverUsed
+	}
+	if now.Sub(*lastUsed) > threshold {
+		return AccessKeyFindingStale
 	}
-	return jobIds, nil
+	return ""
 }
 
-func AttachServiceLastAccessedDetails(client *iam.IAM, result *ReportResult, jobIds []*string) {
-	for i := 0; i < len(jobIds); {
-		jobId := jobIds[i]
-		lastUsed, err := client.GetServiceLastAccessedDetails(&iam.GetServiceLastAccessedDetailsInput{JobId: jobId})
-		if err != nil {
-			result.Error = err
-			return
-		}
-		if *lastUsed.JobStatus == "IN_PROGRESS" {
-			time.Sleep(1 * time.Second)
-			continue
-		}
-		if *lastUsed.JobStatus == "COMPLETED" {
-			result.Resources[i].Metadata["ServiceLastAccessed"] = lastUsed.ServicesLastAccessed
-			var lastUsedAt *time.Time
-			for _, serviceLastAccessed := range lastUsed.ServicesLastAccessed {
-				if serviceLastAccessed.LastAuthenticated == nil {
+// IAMListRiskyPolicies returns the local policies and policy versions that
+// grant admin access, RiskReasons holds the offending statements.
+func IAMListRiskyPolicies(ctx context.Context, session *Session) *ReportResult {
+	client := session.IAMClient()
+
+	result := &ReportResult{}
+	err := client.ListPoliciesPagesWithContext(ctx, &iam.ListPoliciesInput{Scope: aws.String("Local")},
+		func(page *iam.ListPoliciesOutput, lastPage bool) bool {
+			for _, policy := range page.Policies {
+				policyVersions := IAMListPolicyVersions(ctx, session, client, *policy.Arn)
+				result.AddError(policyVersions.Error)
+
+				var defaultReasons []Statement
+				for _, version := range policyVersions.Resources {
+					if _, ok := version.Metadata["Error"]; ok {
+						// already reported by IAMListPolicyVersions
+						continue
+					}
+					reasons, err := policyVersionAdminStatements(version)
+					if err != nil {
+						result.AddError(err)
+						continue
+					}
+					if len(reasons) == 0 {
+						continue
+					}
+					version.Metadata["RiskReasons"] = reasons
+					result.Resources = append(result.Resources, version)
+					if versionID, _ := NormalizeValue(version.Metadata["VersionId"]).(string); versionID == aws.StringValue(policy.DefaultVersionId) {
+						defaultReasons = reasons
+					}
+				}
+
+				if defaultReasons == nil {
 					continue
 				}
-				if lastUsedAt == nil || serviceLastAccessed.LastAuthenticated.After(*lastUsedAt) {
-					lastUsedAt = serviceLastAccessed.LastAuthenticated
+				resource, err := NewResource(*policy.Arn, policy)
+				if err != nil {
+					result.AddError(err)
+					continue
 				}
+				resource.Metadata["RiskReasons"] = defaultReasons
+				result.Resources = append(result.Resources, *resource)
 			}
-			result.Resources[i].Metadata["LastUsed"] = lastUsedAt
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
 
+func policyVersionAdminStatements(version Resource) ([]Statement, error) {
+	encoded, err := json.Marshal(version.Metadata["Document"])
+	if err != nil {
+		return nil, err
+	}
+	document, err := parsePolicyJSON(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", version.ARN, err)
+	}
+	return document.AdminStatements(), nil
+}
+
+// attachLastAccessed adds the service last accessed details to the resources
+// at targets, unless disabled with the SkipLastAccessed option.
+func attachLastAccessed(ctx context.Context, session *Session, client iamiface.IAMAPI, result *ReportResult, arns []*string, targets []int) {
+	if session.options().SkipLastAccessed {
+		return
+	}
+
+	jobIds, err := GenerateServiceLastAccessedDetails(ctx, session, client, arns)
+	result.AddError(err)
+	AttachServiceLastAccessedDetails(ctx, session, client, result, targets, jobIds)
+}
+
+// GenerateServiceLastAccessedDetails starts a job for each ARN, the job ID
+// is nil for the ARNs that failed and their errors are returned together.
+func GenerateServiceLastAccessedDetails(ctx context.Context, session *Session, client iamiface.IAMAPI, arns []*string) ([]*string, error) {
+	jobIds := make([]*string, len(arns))
+	errs := make([]error, len(arns))
+
+	indexes := make(chan int, len(arns))
+	for i := range arns {
+		indexes <- i
+	}
+	close(indexes)
+
+	var wg sync.WaitGroup
+	for w := 0; w < session.options().Concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				job, err := client.GenerateServiceLastAccessedDetailsWithContext(ctx, &iam.GenerateServiceLastAccessedDetailsInput{
+					Arn: arns[i],
+				})
+				if err != nil {
+					errs[i] = fmt.Errorf("failed to generate service last accessed details of %s: %w", aws.StringValue(arns[i]), err)
+					continue
+				}
+				jobIds[i] = job.JobId
+			}
+		}()
+	}
+	wg.Wait()
+
+	errors := &MultiError{}
+	for _, err := range errs {
+		errors.Append(err)
+	}
+	return jobIds, errors.ErrorOrNil()
+}
+
+func AttachServiceLastAccessedDetails(ctx context.Context, session *Session, client iamiface.IAMAPI, result *ReportResult, targets []int, jobIds []*string) {
+	options := session.options()
+	deadline := time.Now().Add(options.LastAccessedMaxWait)
+
+	pending := []int{}
+	for i, jobId := range jobIds {
+		if jobId == nil {
+			result.Resources[targets[i]].Metadata["ServiceLastAccessedError"] = "failed to generate"
+			continue
+		}
+		pending = append(pending, i)
+	}
+
+	for len(pending) > 0 {
+		inProgress := []int{}
+		for _, i := range pending {
+			resource := &result.Resources[targets[i]]
+			lastUsed, err := client.GetServiceLastAccessedDetailsWithContext(ctx, &iam.GetServiceLastAccessedDetailsInput{JobId: jobIds[i]})
+			if err != nil {
+				result.AddError(err)
+				resource.Metadata["ServiceLastAccessedError"] = err.Error()
+				continue
+			}
+			switch *lastUsed.JobStatus {
+			case iam.JobStatusTypeInProgress:
+				inProgress = append(inProgress, i)
+			case iam.JobStatusTypeFailed:
+				resource.Metadata["ServiceLastAccessedError"] = serviceLastAccessedError(lastUsed)
+			case iam.JobStatusTypeCompleted:
+				resource.Metadata["ServiceLastAccessed"] = lastUsed.ServicesLastAccessed
+				var lastUsedAt *time.Time
+				for _, serviceLastAccessed := range lastUsed.ServicesLastAccessed {
+					if serviceLastAccessed.LastAuthenticated == nil {
+						continue
+					}
+					if lastUsedAt == nil || serviceLastAccessed.LastAuthenticated.After(*lastUsedAt) {
+						lastUsedAt = serviceLastAccessed.LastAuthenticated
+					}
+				}
+				resource.Metadata["LastUsed"] = lastUsedAt
+			default:
+				resource.Metadata["ServiceLastAccessedError"] = fmt.Sprintf("unknown job status %s: %s", *lastUsed.JobStatus, serviceLastAccessedError(lastUsed))
+			}
+		}
+
+		pending = inProgress
+		session.logger().Debugf("service last accessed details: %d of %d jobs in progress", len(pending), len(jobIds))
+		if len(pending) > 0 {
+			if time.Now().After(deadline) {
+				for _, i := range pending {
+					result.Resources[targets[i]].Metadata["ServiceLastAccessedError"] = "timeout"
+				}
+				return
+			}
+			select {
+			case <-ctx.Done():
+				result.AddError(ctx.Err())
+				return
+			case <-time.After(options.LastAccessedPollInterval):
+			}
 		}
-		i++
 	}
 }
 
-func IAMListInstanceProfiles(session *Session) *ReportResult {
+func serviceLastAccessedError(lastUsed *iam.GetServiceLastAccessedDetailsOutput) string {
+	if lastUsed.Error == nil {
+		return "failed"
+	}
+	return fmt.Sprintf("%s: %s", aws.StringValue(lastUsed.Error.Code), aws.StringValue(lastUsed.Error.Message))
+}
+
+func IAMListInstanceProfiles(ctx context.Context, session *Session) *ReportResult {
 
-	client := iam.New(session.Session, session.Config)
+	client := session.IAMClient()
 
 	result := &ReportResult{}
-	err := client.ListInstanceProfilesPages(&iam.ListInstanceProfilesInput{},
+	err := client.ListInstanceProfilesPagesWithContext(ctx, &iam.ListInstanceProfilesInput{},
 		func(page *iam.ListInstanceProfilesOutput, lastPage bool) bool {
 			for _, instanceProfile := range page.InstanceProfiles {
 				resource := Resource{
@@ -667,8 +1112,9 @@ func IAMListInstanceProfiles(session *Session) *ReportResult {
 					role := irole.(map[string]interface{})
 					document, err := DecodeInlinePolicyDocument(*role["AssumeRolePolicyDocument"].(*string))
 					if err != nil {
-						result.Error = err
-						return false
+						result.AddError(err)
+						resource.Metadata["Error"] = err.Error()
+						continue
 					}
 					role["AssumeRolePolicyDocument"] = document
 				}
@@ -679,9 +1125,211 @@ func IAMListInstanceProfiles(session *Session) *ReportResult {
 			return true
 		})
 
-	if result.Error != nil {
+	result.AddError(err)
+	return result
+}
+
+const credentialReportMaxAttempts = 8
+
+func fetchCredentialReport(ctx context.Context, client iamiface.IAMAPI) (*iam.GetCredentialReportOutput, error) {
+	delay := 1 * time.Second
+	for attempt := 1; ; attempt++ {
+		generated, err := client.GenerateCredentialReportWithContext(ctx, &iam.GenerateCredentialReportInput{})
+		if err != nil {
+			return nil, err
+		}
+
+		if *generated.State == iam.ReportStateTypeComplete {
+			report, err := client.GetCredentialReportWithContext(ctx, &iam.GetCredentialReportInput{})
+			if err == nil {
+				return report, nil
+			}
+
+			aerr, ok := err.(awserr.Error)
+			if !ok || (aerr.Code() != iam.ErrCodeCredentialReportNotReadyException &&
+				aerr.Code() != iam.ErrCodeCredentialReportNotPresentException) {
+				return nil, err
+			}
+		}
+
+		if attempt >= credentialReportMaxAttempts {
+			return nil, fmt.Errorf("credential report not ready after %d attempts", attempt)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+		if delay < 8*time.Second {
+			delay *= 2
+		}
+	}
+}
+
+func IAMGetCredentialReport(ctx context.Context, session *Session) *ReportResult {
+	client := session.IAMClient()
+
+	result := &ReportResult{}
+	report, err := fetchCredentialReport(ctx, client)
+	if err != nil {
+		result.Error = err
+		return result
+	}
+
+	rows, err := csv.NewReader(bytes.NewReader(report.Content)).ReadAll()
+	if err != nil {
+		result.Error = err
+		return result
+	}
+	if len(rows) == 0 {
+		return result
+	}
+
+	header := rows[0]
+	for _, row := range rows[1:] {
+		metadata := map[string]interface{}{}
+		for i, column := range header {
+			if i < len(row) {
+				metadata[column] = row[i]
+			}
+		}
+
+		user, _ := metadata["user"].(string)
+		arn, _ := metadata["arn"].(string)
+		result.Resources = append(result.Resources, Resource{
+			ID:        user,
+			ARN:       arn,
+			AccountID: session.AccountID,
+			Service:   "iam",
+			Type:      "credential-report-entry",
+			Region:    *session.Config.Region,
+			Metadata:  metadata,
+		})
+	}
+
+	return result
+}
+
+func IAMGetAccountSummary(ctx context.Context, session *Session) *ReportResult {
+	client := session.IAMClient()
+
+	result := &ReportResult{}
+	res, err := client.GetAccountSummaryWithContext(ctx, &iam.GetAccountSummaryInput{})
+	if err != nil {
+		result.Error = err
 		return result
 	}
-	result.Error = err
+
+	metadata := map[string]interface{}{}
+	for key, value := range res.SummaryMap {
+		metadata[key] = aws.Int64Value(value)
+	}
+
+	result.Resources = append(result.Resources, Resource{
+		ID:        session.AccountID,
+		AccountID: session.AccountID,
+		Service:   "iam",
+		Type:      "account-summary",
+		Region:    *session.Config.Region,
+		Metadata:  metadata,
+	})
+	return result
+}
+
+func IAMGetAccountPasswordPolicy(ctx context.Context, session *Session) *ReportResult {
+	client := session.IAMClient()
+
+	resource := Resource{
+		ID:        session.AccountID,
+		AccountID: session.AccountID,
+		Service:   "iam",
+		Type:      "password-policy",
+		Region:    *session.Config.Region,
+	}
+
+	result := &ReportResult{}
+	res, err := client.GetAccountPasswordPolicyWithContext(ctx, &iam.GetAccountPasswordPolicyInput{})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == iam.ErrCodeNoSuchEntityException {
+			resource.Metadata = map[string]interface{}{
+				"Configured": false,
+			}
+			result.Resources = append(result.Resources, resource)
+			return result
+		}
+		result.Error = err
+		return result
+	}
+
+	resource.Metadata = structs.Map(res.PasswordPolicy)
+	resource.Metadata["Configured"] = true
+	result.Resources = append(result.Resources, resource)
+	return result
+}
+
+func IAMListSAMLProviders(ctx context.Context, session *Session) *ReportResult {
+	client := session.IAMClient()
+
+	result := &ReportResult{}
+	res, err := client.ListSAMLProvidersWithContext(ctx, &iam.ListSAMLProvidersInput{})
+	if err != nil {
+		result.Error = err
+		return result
+	}
+
+	for _, provider := range res.SAMLProviderList {
+		details, err := client.GetSAMLProviderWithContext(ctx, &iam.GetSAMLProviderInput{SAMLProviderArn: provider.Arn})
+		if err != nil {
+			result.AddError(err)
+			continue
+		}
+
+		metadata := structs.Map(details)
+		metadata["Arn"] = *provider.Arn
+		result.Resources = append(result.Resources, Resource{
+			ID:        *provider.Arn,
+			ARN:       *provider.Arn,
+			AccountID: session.AccountID,
+			Service:   "iam",
+			Type:      "saml-provider",
+			Region:    *session.Config.Region,
+			Metadata:  metadata,
+		})
+	}
+
+	return result
+}
+
+func IAMListOpenIDConnectProviders(ctx context.Context, session *Session) *ReportResult {
+	client := session.IAMClient()
+
+	result := &ReportResult{}
+	res, err := client.ListOpenIDConnectProvidersWithContext(ctx, &iam.ListOpenIDConnectProvidersInput{})
+	if err != nil {
+		result.Error = err
+		return result
+	}
+
+	for _, provider := range res.OpenIDConnectProviderList {
+		details, err := client.GetOpenIDConnectProviderWithContext(ctx, &iam.GetOpenIDConnectProviderInput{OpenIDConnectProviderArn: provider.Arn})
+		if err != nil {
+			result.AddError(err)
+			continue
+		}
+
+		metadata := structs.Map(details)
+		metadata["Arn"] = *provider.Arn
+		result.Resources = append(result.Resources, Resource{
+			ID:        *provider.Arn,
+			ARN:       *provider.Arn,
+			AccountID: session.AccountID,
+			Service:   "iam",
+			Type:      "oidc-provider",
+			Region:    *session.Config.Region,
+			Metadata:  metadata,
+		})
+	}
+
 	return result
 }
@@ -0,0 +1,279 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeIAM struct {
+	iamiface.IAMAPI
+	policies    map[string][]string
+	attachments map[string]int64
+	jobStatuses map[string]string
+	failedJobs  map[string]bool
+}
+
+func (f *fakeIAM) ListPoliciesPagesWithContext(ctx aws.Context, input *iam.ListPoliciesInput, fn func(*iam.ListPoliciesOutput, bool) bool, opts ...request.Option) error {
+	page := &iam.ListPoliciesOutput{}
+	for arn := range f.policies {
+		page.Policies = append(page.Policies, &iam.Policy{Arn: aws.String(arn), AttachmentCount: aws.Int64(f.attachments[arn])})
+	}
+	fn(page, true)
+	return nil
+}
+
+func (f *fakeIAM) ListPolicyVersionsPagesWithContext(ctx aws.Context, input *iam.ListPolicyVersionsInput, fn func(*iam.ListPolicyVersionsOutput, bool) bool, opts ...request.Option) error {
+	page := &iam.ListPolicyVersionsOutput{}
+	for _, versionID := range f.policies[*input.PolicyArn] {
+		page.Versions = append(page.Versions, &iam.PolicyVersion{VersionId: aws.String(versionID)})
+	}
+	fn(page, true)
+	return nil
+}
+
+func (f *fakeIAM) GetPolicyVersionWithContext(ctx aws.Context, input *iam.GetPolicyVersionInput, opts ...request.Option) (*iam.GetPolicyVersionOutput, error) {
+	return &iam.GetPolicyVersionOutput{
+		PolicyVersion: &iam.PolicyVersion{
+			VersionId: input.VersionId,
+			Document:  aws.String("%7B%7D"),
+		},
+	}, nil
+}
+
+func (f *fakeIAM) GenerateServiceLastAccessedDetailsWithContext(ctx aws.Context, input *iam.GenerateServiceLastAccessedDetailsInput, opts ...request.Option) (*iam.GenerateServiceLastAccessedDetailsOutput, error) {
+	if f.failedJobs[*input.Arn+":generate"] {
+		return nil, errors.New("generate failed")
+	}
+	return &iam.GenerateServiceLastAccessedDetailsOutput{JobId: input.Arn}, nil
+}
+
+func (f *fakeIAM) GetServiceLastAccessedDetailsWithContext(ctx aws.Context, input *iam.GetServiceLastAccessedDetailsInput, opts ...request.Option) (*iam.GetServiceLastAccessedDetailsOutput, error) {
+	if f.failedJobs[*input.JobId+":get"] {
+		return nil, errors.New("get failed")
+	}
+	status, ok := f.jobStatuses[*input.JobId]
+	if !ok {
+		status = iam.JobStatusTypeCompleted
+	}
+	output := &iam.GetServiceLastAccessedDetailsOutput{
+		JobStatus: aws.String(status),
+		ServicesLastAccessed: []*iam.ServiceLastAccessed{
+			&iam.ServiceLastAccessed{ServiceName: input.JobId},
+		},
+	}
+	if status == iam.JobStatusTypeFailed {
+		output.Error = &iam.ErrorDetails{Code: aws.String("InternalError"), Message: aws.String("job failed")}
+	}
+	return output, nil
+}
+
+func TestIAMListPoliciesAttachesLastAccessedToPolicies(t *testing.T) {
+	t.Parallel()
+
+	client := &fakeIAM{
+		policies: map[string][]string{
+			"arn:aws:iam::123456789012:policy/first":  []string{"v1", "v2"},
+			"arn:aws:iam::123456789012:policy/second": []string{"v1", "v2"},
+		},
+	}
+	session := &Session{
+		Config:    &aws.Config{Region: aws.String("us-east-1")},
+		AccountID: "123456789012",
+	}
+
+	result := iamListPolicies(context.Background(), session, client)
+	require.NoError(t, result.Error)
+	require.Len(t, result.Resources, 6)
+
+	for _, resource := range result.Resources {
+		if strings.HasSuffix(resource.ARN, ":v1") || strings.HasSuffix(resource.ARN, ":v2") {
+			require.NotContains(t, resource.Metadata, "ServiceLastAccessed", resource.ARN)
+			continue
+		}
+
+		require.Contains(t, resource.Metadata, "ServiceLastAccessed", resource.ARN)
+		servicesLastAccessed := resource.Metadata["ServiceLastAccessed"].([]*iam.ServiceLastAccessed)
+		require.Len(t, servicesLastAccessed, 1)
+		require.Equal(t, resource.ARN, *servicesLastAccessed[0].ServiceName)
+	}
+}
+
+func TestIAMListPoliciesPartialOptions(t *testing.T) {
+	t.Parallel()
+
+	client := &fakeIAM{
+		policies: map[string][]string{
+			"arn:aws:iam::123456789012:policy/first": []string{"v1"},
+		},
+	}
+	session := &Session{
+		Config:    &aws.Config{Region: aws.String("us-east-1")},
+		AccountID: "123456789012",
+		Options:   &DumpOptions{Deterministic: true},
+	}
+
+	options := session.options()
+	require.Equal(t, DefaultDumpOptions().Concurrency, options.Concurrency)
+	require.Equal(t, DefaultDumpOptions().LastAccessedMaxWait, options.LastAccessedMaxWait)
+	require.Equal(t, DefaultDumpOptions().StaleAccessKeyThreshold, options.StaleAccessKeyThreshold)
+	require.True(t, options.Deterministic)
+
+	result := iamListPolicies(context.Background(), session, client)
+	require.NoError(t, result.Error)
+	require.Contains(t, result.Resources[0].Metadata, "ServiceLastAccessed")
+}
+
+func TestIAMListPoliciesSkipLastAccessed(t *testing.T) {
+	t.Parallel()
+
+	client := &fakeIAM{
+		policies: map[string][]string{
+			"arn:aws:iam::123456789012:policy/first": []string{"v1"},
+		},
+	}
+	options := DefaultDumpOptions()
+	options.SkipLastAccessed = true
+	session := &Session{
+		Config:    &aws.Config{Region: aws.String("us-east-1")},
+		AccountID: "123456789012",
+		Options:   options,
+	}
+
+	result := iamListPolicies(context.Background(), session, client)
+	require.NoError(t, result.Error)
+	require.Len(t, result.Resources, 2)
+	for _, resource := range result.Resources {
+		require.NotContains(t, resource.Metadata, "ServiceLastAccessed", resource.ARN)
+	}
+}
+
+func TestIAMListPoliciesReferencedOnly(t *testing.T) {
+	t.Parallel()
+
+	client := &fakeIAM{
+		policies: map[string][]string{
+			"arn:aws:iam::123456789012:policy/local":         []string{"v1"},
+			"arn:aws:iam::aws:policy/AdministratorAccess":    []string{"v1"},
+			"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess": []string{"v1"},
+		},
+		attachments: map[string]int64{
+			"arn:aws:iam::aws:policy/AdministratorAccess": 2,
+		},
+	}
+	options := DefaultDumpOptions()
+	options.PolicyScope = iam.PolicyScopeTypeAll
+	options.PolicyReferencedOnly = true
+	session := &Session{
+		Config:    &aws.Config{Region: aws.String("us-east-1")},
+		AccountID: "123456789012",
+		Options:   options,
+	}
+
+	result := iamListPolicies(context.Background(), session, client)
+	require.NoError(t, result.Error)
+
+	arns := []string{}
+	for _, resource := range result.Resources {
+		arns = append(arns, resource.ARN)
+	}
+	require.ElementsMatch(t, []string{
+		"arn:aws:iam::123456789012:policy/local",
+		"arn:aws:iam::123456789012:policy/local:v1",
+		"arn:aws:iam::aws:policy/AdministratorAccess",
+		"arn:aws:iam::aws:policy/AdministratorAccess:v1",
+	}, arns)
+}
+
+func TestAttachServiceLastAccessedDetailsHandlesStatuses(t *testing.T) {
+	t.Parallel()
+
+	client := &fakeIAM{
+		jobStatuses: map[string]string{
+			"stuck":   iam.JobStatusTypeInProgress,
+			"failed":  iam.JobStatusTypeFailed,
+			"unknown": "WEDGED",
+		},
+	}
+	options := DefaultDumpOptions()
+	options.LastAccessedPollInterval = 10 * time.Millisecond
+	options.LastAccessedMaxWait = 50 * time.Millisecond
+	session := &Session{Options: options}
+
+	result := &ReportResult{}
+	jobIds := []*string{}
+	targets := []int{}
+	for i, id := range []string{"stuck", "failed", "completed", "unknown"} {
+		result.Resources = append(result.Resources, Resource{ID: id, Metadata: map[string]interface{}{}})
+		jobIds = append(jobIds, aws.String(id))
+		targets = append(targets, i)
+	}
+
+	AttachServiceLastAccessedDetails(context.Background(), session, client, result, targets, jobIds)
+	require.NoError(t, result.Error)
+
+	require.Equal(t, "timeout", result.Resources[0].Metadata["ServiceLastAccessedError"])
+	require.Equal(t, "InternalError: job failed", result.Resources[1].Metadata["ServiceLastAccessedError"])
+	require.NotContains(t, result.Resources[1].Metadata, "ServiceLastAccessed")
+	require.Contains(t, result.Resources[2].Metadata, "ServiceLastAccessed")
+	require.NotContains(t, result.Resources[2].Metadata, "ServiceLastAccessedError")
+	require.Equal(t, "unknown job status WEDGED: failed", result.Resources[3].Metadata["ServiceLastAccessedError"])
+}
+
+func TestAttachLastAccessedSkipsFailedJobs(t *testing.T) {
+	t.Parallel()
+
+	client := &fakeIAM{
+		failedJobs: map[string]bool{
+			"generate:generate": true,
+			"get:get":           true,
+		},
+	}
+	session := &Session{}
+
+	result := &ReportResult{}
+	arns := []*string{}
+	targets := []int{}
+	for i, id := range []string{"generate", "get", "completed"} {
+		result.Resources = append(result.Resources, Resource{ID: id, Metadata: map[string]interface{}{}})
+		arns = append(arns, aws.String(id))
+		targets = append(targets, i)
+	}
+
+	attachLastAccessed(context.Background(), session, client, result, arns, targets)
+	require.Error(t, result.Error)
+	require.Len(t, result.Error.(*MultiError).Errors, 2)
+
+	require.Equal(t, "failed to generate", result.Resources[0].Metadata["ServiceLastAccessedError"])
+	require.Equal(t, "get failed", result.Resources[1].Metadata["ServiceLastAccessedError"])
+	require.Contains(t, result.Resources[2].Metadata, "ServiceLastAccessed")
+}
+
+func TestStaleAccessKeyFinding(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	recently := now.Add(-24 * time.Hour)
+	longAgo := now.Add(-200 * 24 * time.Hour)
+	threshold := DefaultDumpOptions().StaleAccessKeyThreshold
+
+	key := func(status string, lastUsed *time.Time) Resource {
+		return Resource{Metadata: map[string]interface{}{
+			"Status":   aws.String(status),
+			"LastUsed": lastUsed,
+		}}
+	}
+
+	require.Equal(t, "", staleAccessKeyFinding(key(iam.StatusTypeActive, &recently), threshold, now))
+	require.Equal(t, AccessKeyFindingStale, staleAccessKeyFinding(key(iam.StatusTypeActive, &longAgo), threshold, now))
+	require.Equal(t, AccessKeyFindingNeverUsed, staleAccessKeyFinding(key(iam.StatusTypeActive, nil), threshold, now))
+	require.Equal(t, AccessKeyFindingInactive, staleAccessKeyFinding(key(iam.StatusTypeInactive, &recently), threshold, now))
+}
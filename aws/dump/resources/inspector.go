@@ -0,0 +1,94 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/fatih/structs"
+)
+
+var (
+	InspectorService = Service{
+		Name: "inspector2",
+		Reports: map[string]Report{
+			"enablement": InspectorGetEnablement,
+		},
+	}
+)
+
+func init() {
+	RegisterService(InspectorService)
+}
+
+func InspectorGetEnablement(ctx context.Context, session *Session) *ReportResult {
+	client := session.Inspector2Client()
+
+	result := &ReportResult{}
+	resource := Resource{
+		ID:        session.AccountID,
+		AccountID: session.AccountID,
+		Service:   "inspector2",
+		Type:      "enablement",
+		Region:    *session.Config.Region,
+		Metadata: map[string]interface{}{
+			"Enabled": false,
+		},
+	}
+
+	status, err := client.BatchGetAccountStatusWithContext(ctx, &inspector2.BatchGetAccountStatusInput{
+		AccountIds: aws.StringSlice([]string{session.AccountID}),
+	})
+	if err != nil {
+		result.AddError(err)
+		return result
+	}
+
+	for _, account := range status.Accounts {
+		if account.State == nil {
+			continue
+		}
+		resource.Metadata["Status"] = aws.StringValue(account.State.Status)
+		resource.Metadata["Enabled"] = aws.StringValue(account.State.Status) == inspector2.StatusEnabled
+		if account.ResourceState != nil {
+			resource.Metadata["ResourceState"] = structs.Map(account.ResourceState)
+		}
+	}
+
+	if resource.Metadata["Enabled"] == true {
+		findings, err := InspectorFindingsBySeverity(ctx, client, session.AccountID)
+		if err != nil {
+			result.AddError(err)
+			resource.Metadata["Error"] = err.Error()
+		} else {
+			resource.Metadata["FindingsBySeverity"] = findings
+		}
+	}
+
+	result.Resources = append(result.Resources, resource)
+	return result
+}
+
+func InspectorFindingsBySeverity(ctx context.Context, client *inspector2.Inspector2, accountID string) (map[string]int64, error) {
+	counts := map[string]int64{"All": 0, "Critical": 0, "High": 0, "Medium": 0}
+	err := client.ListFindingAggregationsPagesWithContext(ctx, &inspector2.ListFindingAggregationsInput{
+		AggregationType: aws.String(inspector2.AggregationTypeAccount),
+		AccountIds: []*inspector2.StringFilter{
+			{Comparison: aws.String(inspector2.StringComparisonEquals), Value: aws.String(accountID)},
+		},
+	},
+		func(page *inspector2.ListFindingAggregationsOutput, lastPage bool) bool {
+			for _, response := range page.Responses {
+				if response.AccountAggregation == nil || response.AccountAggregation.SeverityCounts == nil {
+					continue
+				}
+				severityCounts := response.AccountAggregation.SeverityCounts
+				counts["All"] += aws.Int64Value(severityCounts.All)
+				counts["Critical"] += aws.Int64Value(severityCounts.Critical)
+				counts["High"] += aws.Int64Value(severityCounts.High)
+				counts["Medium"] += aws.Int64Value(severityCounts.Medium)
+			}
+			return true
+		})
+	return counts, err
+}
@@ -1,21 +1,28 @@
 package resources
 
 import (
+	"context"
 	"fmt"
+	"reflect"
+	"sync"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/fatih/structs"
 	"github.com/hamstah/awstools/common"
 )
 
 type Resource struct {
-	ID        string                 `json:"id"`
-	ARN       string                 `json:"arn"`
-	Service   string                 `json:"service"`
-	Type      string                 `json:"type"`
-	AccountID string                 `json:"account_id"`
-	Region    string                 `json:"region"`
-	Metadata  map[string]interface{} `json:"metadata"`
-	ManagedBy map[string]string      `json:"managed_by"`
+	ID        string `json:"id"`
+	ARN       string `json:"arn"`
+	Service   string `json:"service"`
+	Type      string `json:"type"`
+	AccountID string `json:"account_id"`
+	// AccountAlias is empty when the account has no alias.
+	AccountAlias string                 `json:"account_alias,omitempty"`
+	Region       string                 `json:"region"`
+	Metadata     map[string]interface{} `json:"metadata"`
+	ManagedBy    map[string]string      `json:"managed_by"`
 }
 
 func (r *Resource) UniqueID() string {
@@ -37,15 +44,19 @@ func NewResource(arnstr string, metadata interface{}) (*Resource, error) {
 		id = fmt.Sprintf("%s/%s", id, parsed.Qualifier)
 	}
 
-	return &Resource{
+	resource := &Resource{
 		ID:        id,
 		ARN:       arnstr,
 		Service:   parsed.Service,
 		Type:      parsed.ResourceType,
 		AccountID: parsed.AccountID,
 		Region:    parsed.Region,
-		Metadata:  structs.Map(metadata),
-	}, nil
+		Metadata:  map[string]interface{}{},
+	}
+	if metadata != nil {
+		resource.Metadata = structs.Map(metadata)
+	}
+	return resource, nil
 }
 
 type Service struct {
@@ -71,15 +82,18 @@ func (s *Service) GenerateJobs(account *Account, resource string) ([]Job, error)
 	if !ok {
 		return nil, fmt.Errorf("Unknown resource %s for service %s", resource, s.Name)
 	}
+	name := fmt.Sprintf("%s:%s", s.Name, resource)
 	jobs := []Job{}
 	if s.IsGlobal {
 		jobs = append(jobs, Job{
+			Name:    name,
 			Report:  Report,
 			Session: account.Sessions[0],
 		})
 	} else {
 		for _, session := range account.Sessions {
 			jobs = append(jobs, Job{
+				Name:    name,
 				Report:  Report,
 				Session: session,
 			})
@@ -91,27 +105,53 @@ func (s *Service) GenerateJobs(account *Account, resource string) ([]Job, error)
 type ReportResult struct {
 	Resources []Resource
 	Error     error
+	Metrics   *ReportMetrics
+	// Truncated is set when the report stopped early because of the
+	// MaxItems or MaxPages options.
+	Truncated bool
 }
 
-type Report func(*Session) *ReportResult
+func (r *ReportResult) AddError(err error) {
+	if err == nil {
+		return
+	}
+
+	errors, ok := r.Error.(*MultiError)
+	if !ok {
+		errors = &MultiError{}
+		errors.Append(r.Error)
+		r.Error = errors
+	}
+	errors.Append(err)
+}
+
+func (r *ReportResult) HasErrors() bool {
+	return r.Error != nil
+}
+
+// Report lists the resources of one type for a session. Reports must not
+// panic and must record failures with result.AddError so they end up in
+// result.Error; a partial result is still returned alongside the error.
+type Report func(context.Context, *Session) *ReportResult
 
 type Job struct {
+	Name    string
 	Report  Report
 	Session *Session
 }
 
-func worker(id int, jobs <-chan Job, results chan<- *ReportResult) {
+func worker(ctx context.Context, id int, jobs <-chan Job, results chan<- *ReportResult) {
 	for job := range jobs {
-		results <- job.Report(job.Session)
+		results <- runReport(ctx, job.Session, job.Name, job.Report)
 	}
 }
 
-func Run(jobs []Job) ([]Resource, []error) {
+func Run(ctx context.Context, jobs []Job) ([]Resource, []error) {
 	jobsChan := make(chan Job, len(jobs))
 	results := make(chan *ReportResult, len(jobs))
 
 	for w := 0; w < 10; w++ {
-		go worker(w, jobsChan, results)
+		go worker(ctx, w, jobsChan, results)
 	}
 
 	for _, job := range jobs {
@@ -123,11 +163,183 @@ func Run(jobs []Job) ([]Resource, []error) {
 	errors := []error{}
 	for i := 0; i < len(jobs); i++ {
 		result := <-results
-		if result.Error == nil {
-			resources = append(resources, result.Resources...)
-		} else {
+		resources = append(resources, result.Resources...)
+		if result.Error != nil {
 			errors = append(errors, result.Error)
 		}
 	}
+
+	for _, job := range jobs {
+		if job.Session.options().Deterministic {
+			SortResources(resources)
+			break
+		}
+	}
 	return resources, errors
 }
+
+func runReport(ctx context.Context, session *Session, name string, report Report) (result *ReportResult) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = &ReportResult{Error: fmt.Errorf("report %s panicked: %v", name, r)}
+		}
+	}()
+
+	if _, err := session.ResolveAccountID(); err != nil {
+		return &ReportResult{Error: err}
+	}
+	// the alias is only a label, not being allowed to list it must not fail
+	// the report
+	session.ResolveAccountAlias()
+
+	region := ""
+	if session.Config != nil {
+		region = aws.StringValue(session.Config.Region)
+	}
+	session.logger().Infof("starting report %s in %s for %s", name, region, session.AccountID)
+
+	metrics := &ReportMetrics{}
+	start := time.Now()
+	instrumented := session.withMetrics(metrics)
+	instrumented.reportName = name
+	result = report(ctx, instrumented)
+	if result == nil {
+		result = &ReportResult{}
+	}
+	metrics.Duration = time.Since(start)
+	result.Metrics = metrics
+	for i := range result.Resources {
+		session.labelAccount(&result.Resources[i])
+	}
+	session.logger().Infof("finished report %s in %s for %s: %d resources, %s", name, region, session.AccountID, len(result.Resources), metrics)
+	if session.options().Deterministic {
+		result.SortByARN()
+	}
+	return result
+}
+
+// labelAccount fills the account of resources built from ARNs without one,
+// like S3 buckets, so resources from several accounts can be told apart.
+func (s *Session) labelAccount(resource *Resource) {
+	if resource.AccountID == "" {
+		resource.AccountID = s.AccountID
+	}
+	if resource.AccountAlias == "" {
+		resource.AccountAlias = s.AccountAlias
+	}
+}
+
+func RunReports(ctx context.Context, session *Session, reports map[string]Report, concurrency int) map[string]*ReportResult {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	results := make(map[string]*ReportResult, len(reports))
+	semaphore := make(chan struct{}, concurrency)
+
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	for name, report := range reports {
+		wg.Add(1)
+		go func(name string, report Report) {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			result := runReport(ctx, session, name, report)
+
+			mutex.Lock()
+			results[name] = result
+			mutex.Unlock()
+		}(name, report)
+	}
+	wg.Wait()
+
+	return results
+}
+
+func isGlobalReport(report Report) bool {
+	pointer := reflect.ValueOf(report).Pointer()
+	for _, service := range AllServices() {
+		if !service.IsGlobal {
+			continue
+		}
+		for _, candidate := range service.Reports {
+			if reflect.ValueOf(candidate).Pointer() == pointer {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// RunAcrossRegions runs the report in each region, name is the
+// "service:report" name used in logs and progress callbacks.
+func RunAcrossRegions(ctx context.Context, session *Session, regions []string, name string, report Report) *ReportResult {
+	if len(regions) == 0 || isGlobalReport(report) {
+		regions = []string{*session.Config.Region}
+	}
+	session.ResolveAccountAlias()
+
+	results := make([]*ReportResult, len(regions))
+	var wg sync.WaitGroup
+	for i, region := range regions {
+		wg.Add(1)
+		go func(i int, region string) {
+			defer wg.Done()
+			results[i] = runReport(ctx, session.ForRegion(region), name, report)
+		}(i, region)
+	}
+	wg.Wait()
+
+	merged := &ReportResult{Metrics: &ReportMetrics{}}
+	errors := &MultiError{}
+	for i, result := range results {
+		merged.Truncated = merged.Truncated || result.Truncated
+		if result.Metrics != nil {
+			merged.Metrics.APICalls += result.Metrics.APICalls
+			merged.Metrics.RetryCount += result.Metrics.RetryCount
+			// regions run concurrently so the slowest one is the duration
+			if result.Metrics.Duration > merged.Metrics.Duration {
+				merged.Metrics.Duration = result.Metrics.Duration
+			}
+		}
+		for _, resource := range result.Resources {
+			if resource.Region == "" {
+				resource.Region = regions[i]
+			}
+			merged.Resources = append(merged.Resources, resource)
+		}
+		if result.Error != nil {
+			errors.Append(fmt.Errorf("%s: %w", regions[i], result.Error))
+		}
+	}
+	merged.Error = errors.ErrorOrNil()
+	if session.options().Deterministic {
+		merged.SortByARN()
+	}
+	return merged
+}
+
+// MergeResults concatenates the results, typically from several accounts.
+// Resources keep their AccountID and AccountAlias so they can be grouped.
+func MergeResults(results ...*ReportResult) *ReportResult {
+	merged := &ReportResult{Resources: []Resource{}, Metrics: &ReportMetrics{}}
+	errors := &MultiError{}
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+		merged.Resources = append(merged.Resources, result.Resources...)
+		merged.Truncated = merged.Truncated || result.Truncated
+		if result.Metrics != nil {
+			merged.Metrics.APICalls += result.Metrics.APICalls
+			merged.Metrics.RetryCount += result.Metrics.RetryCount
+			merged.Metrics.Duration += result.Metrics.Duration
+		}
+		errors.Append(result.Error)
+	}
+	merged.Error = errors.ErrorOrNil()
+	return merged
+}
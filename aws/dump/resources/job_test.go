@@ -1,9 +1,14 @@
 package resources
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/hamstah/awstools/common"
 	"github.com/stretchr/testify/require"
 )
@@ -27,3 +32,200 @@ func TestParseArn(t *testing.T) {
 	}
 
 }
+
+func TestRunReports(t *testing.T) {
+	t.Parallel()
+
+	sleeping := func(ctx context.Context, session *Session) *ReportResult {
+		time.Sleep(100 * time.Millisecond)
+		return &ReportResult{Resources: []Resource{{ID: "resource"}}}
+	}
+
+	reports := map[string]Report{
+		"first":  sleeping,
+		"second": sleeping,
+		"third":  sleeping,
+		"fourth": sleeping,
+		"failing": func(ctx context.Context, session *Session) *ReportResult {
+			time.Sleep(100 * time.Millisecond)
+			return &ReportResult{Error: errors.New("failed")}
+		},
+		"panicking": func(ctx context.Context, session *Session) *ReportResult {
+			time.Sleep(100 * time.Millisecond)
+			panic("boom")
+		},
+	}
+
+	start := time.Now()
+	results := RunReports(context.Background(), &Session{AccountID: "123456789012"}, reports, 3)
+	elapsed := time.Since(start)
+
+	require.GreaterOrEqual(t, elapsed, 200*time.Millisecond)
+	require.Less(t, elapsed, 500*time.Millisecond)
+
+	require.Len(t, results, len(reports))
+	for _, name := range []string{"first", "second", "third", "fourth"} {
+		require.NoError(t, results[name].Error)
+		require.Len(t, results[name].Resources, 1)
+		require.GreaterOrEqual(t, results[name].Metrics.Duration, 100*time.Millisecond)
+	}
+	require.EqualError(t, results["failing"].Error, "failed")
+	require.Error(t, results["panicking"].Error)
+	require.Contains(t, results["panicking"].Error.Error(), "boom")
+}
+
+func TestMergeResultsKeepsAccountLabels(t *testing.T) {
+	t.Parallel()
+
+	report := func(ctx context.Context, session *Session) *ReportResult {
+		return &ReportResult{Resources: []Resource{{ID: "bucket", ARN: "arn:aws:s3:::bucket"}}}
+	}
+
+	first := runReport(context.Background(), &Session{AccountID: "111111111111", AccountAlias: "prod"}, "s3:buckets", report)
+	second := runReport(context.Background(), &Session{AccountID: "222222222222"}, "s3:buckets", report)
+	second.Error = errors.New("failed")
+
+	merged := MergeResults(first, second, nil)
+	require.EqualError(t, merged.Error, "failed")
+	require.Len(t, merged.Resources, 2)
+	require.Equal(t, "111111111111", merged.Resources[0].AccountID)
+	require.Equal(t, "prod", merged.Resources[0].AccountAlias)
+	require.Equal(t, "222222222222", merged.Resources[1].AccountID)
+	require.Equal(t, "", merged.Resources[1].AccountAlias)
+}
+
+type recordingLogger struct {
+	mutex    sync.Mutex
+	messages []string
+}
+
+func (l *recordingLogger) record(format string, args ...interface{}) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.messages = append(l.messages, fmt.Sprintf(format, args...))
+}
+
+func (l *recordingLogger) Debugf(format string, args ...interface{}) { l.record(format, args...) }
+func (l *recordingLogger) Infof(format string, args ...interface{})  { l.record(format, args...) }
+func (l *recordingLogger) Warnf(format string, args ...interface{})  { l.record(format, args...) }
+
+func TestRunReportLogs(t *testing.T) {
+	t.Parallel()
+
+	logger := &recordingLogger{}
+	options := DefaultDumpOptions()
+	options.Logger = logger
+	session := &Session{AccountID: "123456789012", Options: options}
+
+	report := func(ctx context.Context, session *Session) *ReportResult {
+		return &ReportResult{Resources: []Resource{{ID: "resource"}}}
+	}
+	runReport(context.Background(), session, "test:report", report)
+
+	require.Len(t, logger.messages, 2)
+	require.Contains(t, logger.messages[0], "starting report test:report")
+	require.Contains(t, logger.messages[1], "finished report test:report")
+	require.Contains(t, logger.messages[1], "1 resources")
+}
+
+func TestRunReportProgress(t *testing.T) {
+	t.Parallel()
+
+	var calls []string
+	options := DefaultDumpOptions()
+	options.Progress = func(service, report string, resourcesSoFar int) {
+		calls = append(calls, fmt.Sprintf("%s %s %d", service, report, resourcesSoFar))
+	}
+	session := &Session{AccountID: "123456789012", Options: options}
+
+	report := func(ctx context.Context, session *Session) *ReportResult {
+		session.progress(1)
+		session.progress(2)
+		return &ReportResult{}
+	}
+	runReport(context.Background(), session, "iam:users-and-access-keys", report)
+
+	require.Equal(t, []string{"iam users-and-access-keys 1", "iam users-and-access-keys 2"}, calls)
+}
+
+func TestRunAcrossRegionsNamesReport(t *testing.T) {
+	t.Parallel()
+
+	logger := &recordingLogger{}
+	var mutex sync.Mutex
+	var calls []string
+	options := DefaultDumpOptions()
+	options.Logger = logger
+	options.Progress = func(service, report string, resourcesSoFar int) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		calls = append(calls, fmt.Sprintf("%s %s", service, report))
+	}
+	session := &Session{
+		Config:    &aws.Config{Region: aws.String("us-east-1")},
+		AccountID: "123456789012",
+		Options:   options,
+	}
+
+	report := func(ctx context.Context, session *Session) *ReportResult {
+		session.progress(1)
+		return &ReportResult{}
+	}
+	result := RunAcrossRegions(context.Background(), session, []string{"us-east-1", "eu-west-1"}, "ec2:instances", report)
+	require.NoError(t, result.Error)
+
+	require.Equal(t, []string{"ec2 instances", "ec2 instances"}, calls)
+	require.Len(t, logger.messages, 4)
+	for _, region := range []string{"us-east-1", "eu-west-1"} {
+		require.Contains(t, logger.messages, fmt.Sprintf("starting report ec2:instances in %s for 123456789012", region))
+	}
+}
+
+type recordingSink struct {
+	mutex     sync.Mutex
+	resources []Resource
+}
+
+func (s *recordingSink) Emit(resource Resource) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.resources = append(s.resources, resource)
+	return nil
+}
+
+func TestRunReportLabelsStreamedResources(t *testing.T) {
+	t.Parallel()
+
+	sink := &recordingSink{}
+	session := &Session{
+		Config:       &aws.Config{Region: aws.String("eu-west-1")},
+		AccountID:    "123456789012",
+		AccountAlias: "production",
+		Sink:         &dedupSink{sink: sink, emitted: map[string]bool{}},
+	}
+
+	report := func(ctx context.Context, session *Session) *ReportResult {
+		resource := Resource{ID: "user", ARN: "arn:aws:iam::123456789012:user/user"}
+		session.Emit(resource)
+		return &ReportResult{Resources: []Resource{resource}}
+	}
+	result := runReport(context.Background(), session, "iam:users", report)
+	for _, resource := range result.Resources {
+		require.NoError(t, session.Emit(resource))
+	}
+
+	require.Len(t, sink.resources, 1)
+	require.Equal(t, "123456789012", sink.resources[0].AccountID)
+	require.Equal(t, "production", sink.resources[0].AccountAlias)
+	require.Equal(t, "eu-west-1", sink.resources[0].Region)
+}
+
+func TestWithAssumedRoleWithoutSession(t *testing.T) {
+	t.Parallel()
+
+	session := &Session{Config: &aws.Config{Region: aws.String("us-east-1")}}
+	_, err := session.WithAssumedRole("arn:aws:iam::123456789012:role/audit", "")
+	require.Error(t, err)
+	_, err = session.WithAssumedRoleChain([]string{"arn:aws:iam::123456789012:role/audit"})
+	require.Error(t, err)
+}
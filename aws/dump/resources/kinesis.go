@@ -0,0 +1,159 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+)
+
+var (
+	KinesisService = Service{
+		Name: "kinesis",
+		Reports: map[string]Report{
+			"streams":          KinesisListStreams,
+			"delivery-streams": FirehoseListDeliveryStreams,
+		},
+	}
+)
+
+func init() {
+	RegisterService(KinesisService)
+}
+
+func KinesisListStreams(ctx context.Context, session *Session) *ReportResult {
+	client := session.KinesisClient()
+
+	result := &ReportResult{}
+	err := client.ListStreamsPagesWithContext(ctx, &kinesis.ListStreamsInput{},
+		func(page *kinesis.ListStreamsOutput, lastPage bool) bool {
+			for _, name := range page.StreamNames {
+				described, err := client.DescribeStreamSummaryWithContext(ctx, &kinesis.DescribeStreamSummaryInput{StreamName: name})
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				stream := described.StreamDescriptionSummary
+
+				resource, err := NewResource(*stream.StreamARN, stream)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "stream"
+				resource.Metadata["ShardCount"] = aws.Int64Value(stream.OpenShardCount)
+				resource.Metadata["RetentionPeriodHours"] = aws.Int64Value(stream.RetentionPeriodHours)
+				resource.Metadata["EncryptionType"] = aws.StringValue(stream.EncryptionType)
+				resource.Metadata["KeyId"] = aws.StringValue(stream.KeyId)
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
+
+func FirehoseListDeliveryStreams(ctx context.Context, session *Session) *ReportResult {
+	client := session.FirehoseClient()
+
+	result := &ReportResult{}
+	input := &firehose.ListDeliveryStreamsInput{}
+	for {
+		page, err := client.ListDeliveryStreamsWithContext(ctx, input)
+		if err != nil {
+			result.AddError(err)
+			return result
+		}
+
+		for _, name := range page.DeliveryStreamNames {
+			described, err := client.DescribeDeliveryStreamWithContext(ctx, &firehose.DescribeDeliveryStreamInput{DeliveryStreamName: name})
+			if err != nil {
+				result.AddError(err)
+				continue
+			}
+			stream := described.DeliveryStreamDescription
+
+			// Destinations hold credentials like the Splunk HEC token, only
+			// a summary of each is kept.
+			destinations := []map[string]interface{}{}
+			for _, destination := range stream.Destinations {
+				destinations = append(destinations, firehoseDestinationSummary(destination))
+			}
+			stream.Destinations = nil
+
+			resource, err := NewResource(*stream.DeliveryStreamARN, stream)
+			if err != nil {
+				result.AddError(err)
+				continue
+			}
+			resource.Type = "delivery-stream"
+			resource.Metadata["Destinations"] = destinations
+			result.Resources = append(result.Resources, *resource)
+		}
+
+		if !aws.BoolValue(page.HasMoreDeliveryStreams) || len(page.DeliveryStreamNames) == 0 {
+			return result
+		}
+		input.ExclusiveStartDeliveryStreamName = page.DeliveryStreamNames[len(page.DeliveryStreamNames)-1]
+	}
+}
+
+func firehoseDestinationSummary(destination *firehose.DestinationDescription) map[string]interface{} {
+	summary := map[string]interface{}{
+		"DestinationId": aws.StringValue(destination.DestinationId),
+	}
+
+	var roleARN, target, backupMode *string
+	switch {
+	case destination.ExtendedS3DestinationDescription != nil:
+		description := destination.ExtendedS3DestinationDescription
+		summary["Type"] = "S3"
+		roleARN, target, backupMode = description.RoleARN, description.BucketARN, description.S3BackupMode
+	case destination.S3DestinationDescription != nil:
+		description := destination.S3DestinationDescription
+		summary["Type"] = "S3"
+		roleARN, target = description.RoleARN, description.BucketARN
+	case destination.RedshiftDestinationDescription != nil:
+		description := destination.RedshiftDestinationDescription
+		summary["Type"] = "Redshift"
+		roleARN, target, backupMode = description.RoleARN, description.ClusterJDBCURL, description.S3BackupMode
+	case destination.AmazonopensearchserviceDestinationDescription != nil:
+		description := destination.AmazonopensearchserviceDestinationDescription
+		summary["Type"] = "OpenSearch"
+		roleARN, target, backupMode = description.RoleARN, description.DomainARN, description.S3BackupMode
+	case destination.AmazonOpenSearchServerlessDestinationDescription != nil:
+		description := destination.AmazonOpenSearchServerlessDestinationDescription
+		summary["Type"] = "OpenSearchServerless"
+		roleARN, target, backupMode = description.RoleARN, description.CollectionEndpoint, description.S3BackupMode
+	case destination.ElasticsearchDestinationDescription != nil:
+		description := destination.ElasticsearchDestinationDescription
+		summary["Type"] = "Elasticsearch"
+		roleARN, target, backupMode = description.RoleARN, description.DomainARN, description.S3BackupMode
+	case destination.SplunkDestinationDescription != nil:
+		description := destination.SplunkDestinationDescription
+		summary["Type"] = "Splunk"
+		target, backupMode = description.HECEndpoint, description.S3BackupMode
+	case destination.HttpEndpointDestinationDescription != nil:
+		description := destination.HttpEndpointDestinationDescription
+		summary["Type"] = "HttpEndpoint"
+		roleARN, backupMode = description.RoleARN, description.S3BackupMode
+		if description.EndpointConfiguration != nil {
+			summary["Name"] = aws.StringValue(description.EndpointConfiguration.Name)
+		}
+	}
+
+	summary["RoleARN"] = aws.StringValue(roleARN)
+	summary["Target"] = aws.StringValue(target)
+	summary["S3BackupMode"] = aws.StringValue(backupMode)
+	// FailedDocumentsOnly and the like only back up what couldn't be delivered
+	switch aws.StringValue(backupMode) {
+	case firehose.S3BackupModeEnabled, firehose.AmazonopensearchserviceS3BackupModeAllDocuments,
+		firehose.SplunkS3BackupModeAllEvents, firehose.HttpEndpointS3BackupModeAllData:
+		summary["S3BackupEnabled"] = true
+	default:
+		summary["S3BackupEnabled"] = false
+	}
+	return summary
+}
@@ -1,8 +1,10 @@
 package resources
 
 import (
+	"context"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/fatih/structs"
 )
@@ -17,53 +19,76 @@ var (
 	}
 )
 
-func KMSListKeys(session *Session) *ReportResult {
-	client := kms.New(session.Session, session.Config)
+func init() {
+	RegisterService(KMSService)
+}
+
+func KMSListKeys(ctx context.Context, session *Session) *ReportResult {
+	client := session.KMSClient()
 
 	result := &ReportResult{}
-	result.Error = client.ListKeysPages(&kms.ListKeysInput{},
+	err := client.ListKeysPagesWithContext(ctx, &kms.ListKeysInput{},
 		func(page *kms.ListKeysOutput, lastPage bool) bool {
 			for _, key := range page.Keys {
 
 				resource, err := NewResource(*key.KeyArn, key)
 				if err != nil {
-					result.Error = err
-					return false
+					result.AddError(err)
+					continue
 				}
 
-				describeResult, err := client.DescribeKey(&kms.DescribeKeyInput{KeyId: key.KeyId})
+				describeResult, err := client.DescribeKeyWithContext(ctx, &kms.DescribeKeyInput{KeyId: key.KeyId})
 				if err != nil {
-					result.Error = err
-					return false
+					// key policies commonly deny the caller, keep the key
+					result.AddError(err)
+					resource.Metadata["Error"] = err.Error()
+					result.Resources = append(result.Resources, *resource)
+					continue
 				}
 
 				metadata := describeResult.KeyMetadata
-
-				// Ignore default KMS keys
-				if *metadata.KeyManager == kms.KeyManagerTypeAws {
-					continue
+				resource.Metadata = structs.Map(metadata)
+				resource.Metadata["Managed"] = *metadata.KeyManager == kms.KeyManagerTypeAws
+				resource.Metadata["PendingDeletion"] = *metadata.KeyState == kms.KeyStatePendingDeletion
+
+				resource.Metadata["RotationEnabled"] = nil
+				rotation, err := client.GetKeyRotationStatusWithContext(ctx, &kms.GetKeyRotationStatusInput{KeyId: key.KeyId})
+				if err != nil && !IsAWSErrorCode(err, "AccessDeniedException", kms.ErrCodeUnsupportedOperationException, kms.ErrCodeInvalidStateException) {
+					result.AddError(err)
+					resource.Metadata["RotationError"] = err.Error()
+				} else if err == nil {
+					resource.Metadata["RotationEnabled"] = rotation.KeyRotationEnabled
 				}
 
-				// ignore deleted keys
-				if *metadata.KeyState == kms.KeyStatePendingDeletion {
-					continue
+				tags := map[string]string{}
+				err = client.ListResourceTagsPagesWithContext(ctx, &kms.ListResourceTagsInput{KeyId: key.KeyId},
+					func(page *kms.ListResourceTagsOutput, lastPage bool) bool {
+						for _, tag := range page.Tags {
+							tags[*tag.TagKey] = aws.StringValue(tag.TagValue)
+						}
+						return true
+					})
+				if err != nil && !IsAWSErrorCode(err, "AccessDeniedException") {
+					result.AddError(err)
+					resource.Metadata["TagsError"] = err.Error()
 				}
+				resource.Metadata["TagsMap"] = tags
 
-				resource.Metadata = structs.Map(metadata)
 				result.Resources = append(result.Resources, *resource)
 			}
 
 			return true
 		})
 
+	result.AddError(err)
 	return result
 }
 
-func KMSListAliases(session *Session) *ReportResult {
-	client := kms.New(session.Session, session.Config)
+func KMSListAliases(ctx context.Context, session *Session) *ReportResult {
+	client := session.KMSClient()
 
 	result := &ReportResult{}
-	result.Error = client.ListAliasesPages(&kms.ListAliasesInput{},
+	result.Error = client.ListAliasesPagesWithContext(ctx, &kms.ListAliasesInput{},
 		func(page *kms.ListAliasesOutput, lastPage bool) bool {
 			for _, alias := range page.Aliases {
 
@@ -1,6 +1,9 @@
 package resources
 
 import (
+	"context"
+	"sort"
+
 	"github.com/aws/aws-sdk-go/service/lambda"
 )
 
@@ -14,32 +17,64 @@ var (
 	}
 )
 
-func LambdaListFunctions(session *Session) *ReportResult {
-	client := lambda.New(session.Session, session.Config)
+func init() {
+	RegisterService(LambdaService)
+}
+
+func LambdaListFunctions(ctx context.Context, session *Session) *ReportResult {
+	client := session.LambdaClient()
 
 	result := &ReportResult{}
-	result.Error = client.ListFunctionsPages(&lambda.ListFunctionsInput{},
+	result.AddError(client.ListFunctionsPagesWithContext(ctx, &lambda.ListFunctionsInput{},
 		func(page *lambda.ListFunctionsOutput, lastPage bool) bool {
 			for _, function := range page.Functions {
 				resource, err := NewResource(*function.FunctionArn, function)
 				if err != nil {
-					result.Error = err
-					return false
+					result.AddError(err)
+					continue
 				}
+				resource.Type = "function"
+
+				// Environment variable values can contain secrets, only keep the names
+				delete(resource.Metadata, "Environment")
+				variableNames := []string{}
+				if function.Environment != nil {
+					for name := range function.Environment.Variables {
+						variableNames = append(variableNames, name)
+					}
+				}
+				sort.Strings(variableNames)
+				resource.Metadata["EnvironmentVariableNames"] = variableNames
+
+				resource.Metadata["Policy"] = nil
+				policy, err := client.GetPolicyWithContext(ctx, &lambda.GetPolicyInput{FunctionName: function.FunctionArn})
+				if err != nil && !IsAWSErrorCode(err, lambda.ErrCodeResourceNotFoundException) {
+					result.AddError(err)
+					resource.Metadata["Error"] = err.Error()
+				} else if err == nil {
+					document, err := DecodeInlinePolicyDocument(*policy.Policy)
+					if err != nil {
+						result.AddError(err)
+						resource.Metadata["Error"] = err.Error()
+					} else {
+						resource.Metadata["Policy"] = document
+					}
+				}
+
 				result.Resources = append(result.Resources, *resource)
 			}
 
 			return true
-		})
+		}))
 
 	return result
 }
 
-func LambdaListEventSourceMappings(session *Session) *ReportResult {
-	client := lambda.New(session.Session, session.Config)
+func LambdaListEventSourceMappings(ctx context.Context, session *Session) *ReportResult {
+	client := session.LambdaClient()
 
 	result := &ReportResult{}
-	result.Error = client.ListEventSourceMappingsPages(&lambda.ListEventSourceMappingsInput{},
+	result.Error = client.ListEventSourceMappingsPagesWithContext(ctx, &lambda.ListEventSourceMappingsInput{},
 		func(page *lambda.ListEventSourceMappingsOutput, lastPage bool) bool {
 			for _, eventSource := range page.EventSourceMappings {
 				resource, err := NewResource(*eventSource.EventSourceArn, eventSource)
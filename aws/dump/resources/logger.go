@@ -0,0 +1,22 @@
+package resources
+
+// Logger receives progress messages from the reports. It is satisfied by
+// most logging libraries, logrus included.
+type Logger interface {
+	Debugf(format string, args ...interface{})
+	Infof(format string, args ...interface{})
+	Warnf(format string, args ...interface{})
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Debugf(format string, args ...interface{}) {}
+func (nopLogger) Infof(format string, args ...interface{})  {}
+func (nopLogger) Warnf(format string, args ...interface{})  {}
+
+func (s *Session) logger() Logger {
+	if logger := s.options().Logger; logger != nil {
+		return logger
+	}
+	return nopLogger{}
+}
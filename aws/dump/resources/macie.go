@@ -0,0 +1,90 @@
+package resources
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/fatih/structs"
+)
+
+var (
+	MacieService = Service{
+		Name: "macie2",
+		Reports: map[string]Report{
+			"enablement": MacieGetEnablement,
+		},
+	}
+)
+
+func init() {
+	RegisterService(MacieService)
+}
+
+func MacieGetEnablement(ctx context.Context, session *Session) *ReportResult {
+	client := session.Macie2Client()
+
+	result := &ReportResult{}
+	resource := Resource{
+		ID:        session.AccountID,
+		AccountID: session.AccountID,
+		Service:   "macie2",
+		Type:      "enablement",
+		Region:    *session.Config.Region,
+		Metadata: map[string]interface{}{
+			"Enabled": false,
+		},
+	}
+
+	macieSession, err := client.GetMacieSessionWithContext(ctx, &macie2.GetMacieSessionInput{})
+	if err != nil {
+		if !isMacieNotEnabled(err) {
+			result.AddError(err)
+			return result
+		}
+		result.Resources = append(result.Resources, resource)
+		return result
+	}
+
+	resource.Metadata = structs.Map(macieSession)
+	resource.Metadata["Enabled"] = aws.StringValue(macieSession.Status) == macie2.MacieStatusEnabled
+
+	if resource.Metadata["Enabled"] == true {
+		findings, err := MacieFindingsBySeverity(ctx, client)
+		if err != nil {
+			result.AddError(err)
+			resource.Metadata["Error"] = err.Error()
+		} else {
+			resource.Metadata["FindingsBySeverity"] = findings
+		}
+	}
+
+	result.Resources = append(result.Resources, resource)
+	return result
+}
+
+// Macie answers with an access denied error when it isn't enabled, unlike a
+// real permission issue the message says so.
+func isMacieNotEnabled(err error) bool {
+	if !IsAWSErrorCode(err, "AccessDeniedException") {
+		return false
+	}
+	return strings.Contains(err.(awserr.Error).Message(), "not enabled")
+}
+
+func MacieFindingsBySeverity(ctx context.Context, client *macie2.Macie2) (map[string]int64, error) {
+	statistics, err := client.GetFindingStatisticsWithContext(ctx, &macie2.GetFindingStatisticsInput{
+		GroupBy: aws.String(macie2.GroupBySeverityDescription),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[string]int64{"Low": 0, "Medium": 0, "High": 0}
+	for _, group := range statistics.CountsByGroup {
+		counts[aws.StringValue(group.GroupKey)] += aws.Int64Value(group.Count)
+	}
+	return counts, nil
+}
@@ -0,0 +1,117 @@
+package resources
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"sort"
+	"time"
+)
+
+type Manifest struct {
+	GeneratedAt time.Time          `json:"generated_at"`
+	AccountIDs  []string           `json:"account_ids"`
+	Regions     []string           `json:"regions"`
+	Reports     []ManifestReport   `json:"reports"`
+	Artifacts   []ManifestArtifact `json:"artifacts"`
+}
+
+type ManifestReport struct {
+	Name          string `json:"name"`
+	ResourceCount int    `json:"resource_count"`
+	Truncated     bool   `json:"truncated,omitempty"`
+	Error         string `json:"error,omitempty"`
+}
+
+type ManifestArtifact struct {
+	Name   string `json:"name"`
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// WriteManifest describes a dump: the reports that ran, the accounts and
+// regions covered and the checksum of each file in artifacts, which maps
+// names to paths.
+func WriteManifest(w io.Writer, results map[string]*ReportResult, artifacts map[string]string) error {
+	manifest := Manifest{
+		GeneratedAt: time.Now().UTC(),
+		AccountIDs:  []string{},
+		Regions:     []string{},
+		Reports:     []ManifestReport{},
+		Artifacts:   []ManifestArtifact{},
+	}
+
+	accounts := map[string]bool{}
+	regions := map[string]bool{}
+	for name, result := range results {
+		report := ManifestReport{Name: name}
+		if result != nil {
+			report.ResourceCount = len(result.Resources)
+			report.Truncated = result.Truncated
+			if result.Error != nil {
+				report.Error = result.Error.Error()
+			}
+			for _, resource := range result.Resources {
+				if resource.AccountID != "" {
+					accounts[resource.AccountID] = true
+				}
+				if resource.Region != "" {
+					regions[resource.Region] = true
+				}
+			}
+		}
+		manifest.Reports = append(manifest.Reports, report)
+	}
+	sort.Slice(manifest.Reports, func(i, j int) bool {
+		return manifest.Reports[i].Name < manifest.Reports[j].Name
+	})
+	for account := range accounts {
+		manifest.AccountIDs = append(manifest.AccountIDs, account)
+	}
+	sort.Strings(manifest.AccountIDs)
+	for region := range regions {
+		manifest.Regions = append(manifest.Regions, region)
+	}
+	sort.Strings(manifest.Regions)
+
+	for name, path := range artifacts {
+		artifact, err := manifestArtifact(name, path)
+		if err != nil {
+			return err
+		}
+		manifest.Artifacts = append(manifest.Artifacts, artifact)
+	}
+	sort.Slice(manifest.Artifacts, func(i, j int) bool {
+		return manifest.Artifacts[i].Name < manifest.Artifacts[j].Name
+	})
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+func manifestArtifact(name, path string) (ManifestArtifact, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return ManifestArtifact{}, err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, file)
+	if err != nil {
+		return ManifestArtifact{}, err
+	}
+	return ManifestArtifact{
+		Name:   name,
+		Path:   path,
+		Size:   size,
+		SHA256: hex.EncodeToString(hash.Sum(nil)),
+	}, nil
+}
@@ -0,0 +1,50 @@
+package resources
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteManifest(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "dump.json")
+	require.NoError(t, os.WriteFile(path, []byte("hello"), 0600))
+
+	results := map[string]*ReportResult{
+		"s3:buckets": {Resources: []Resource{{ID: "bucket", AccountID: "123456789012"}}},
+		"ec2:instances": {
+			Resources: []Resource{
+				{ID: "i-1", AccountID: "123456789012", Region: "eu-west-1"},
+				{ID: "i-2", AccountID: "123456789012", Region: "us-east-1"},
+			},
+			Error: errors.New("failed"),
+		},
+	}
+
+	buffer := &bytes.Buffer{}
+	require.NoError(t, WriteManifest(buffer, results, map[string]string{"dump": path}))
+
+	manifest := Manifest{}
+	require.NoError(t, json.Unmarshal(buffer.Bytes(), &manifest))
+	require.Equal(t, []string{"123456789012"}, manifest.AccountIDs)
+	require.Equal(t, []string{"eu-west-1", "us-east-1"}, manifest.Regions)
+	require.Equal(t, []ManifestReport{
+		{Name: "ec2:instances", ResourceCount: 2, Error: "failed"},
+		{Name: "s3:buckets", ResourceCount: 1},
+	}, manifest.Reports)
+	require.Equal(t, []ManifestArtifact{{
+		Name:   "dump",
+		Path:   path,
+		Size:   5,
+		SHA256: "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+	}}, manifest.Artifacts)
+
+	require.Error(t, WriteManifest(&bytes.Buffer{}, results, map[string]string{"missing": path + ".missing"}))
+}
@@ -0,0 +1,59 @@
+package resources
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+type ReportMetrics struct {
+	Duration   time.Duration
+	APICalls   int64
+	RetryCount int64
+}
+
+func (m *ReportMetrics) String() string {
+	return fmt.Sprintf("%d calls, %d retries, %s", m.APICalls, m.RetryCount, m.Duration.Round(time.Second))
+}
+
+const metricsHandlerName = "awstools.dump.ReportMetrics"
+
+// withMetrics returns a copy of the session counting every request sent,
+// including retries, in metrics.
+func (s *Session) withMetrics(metrics *ReportMetrics) *Session {
+	s.accountIDMutex.Lock()
+	defer s.accountIDMutex.Unlock()
+
+	instrumented := &Session{
+		Session:              s.Session,
+		Config:               s.Config,
+		AccountID:            s.AccountID,
+		AccountAlias:         s.AccountAlias,
+		Sink:                 s.Sink,
+		RoleSessionName:      s.RoleSessionName,
+		Options:              s.Options,
+		accountAliasResolved: s.accountAliasResolved,
+		reportName:           s.reportName,
+	}
+	if s.Session == nil {
+		return instrumented
+	}
+
+	logger := s.logger()
+	instrumented.Session = s.Session.Copy()
+	instrumented.Session.Handlers.Send.PushFrontNamed(request.NamedHandler{
+		Name: metricsHandlerName,
+		Fn: func(r *request.Request) {
+			atomic.AddInt64(&metrics.APICalls, 1)
+			if r.RetryCount > 0 {
+				atomic.AddInt64(&metrics.RetryCount, 1)
+				logger.Warnf("retrying %s.%s in %s, attempt %d", r.ClientInfo.ServiceName, r.Operation.Name, aws.StringValue(r.Config.Region), r.RetryCount)
+			}
+			logger.Debugf("calling %s.%s in %s", r.ClientInfo.ServiceName, r.Operation.Name, aws.StringValue(r.Config.Region))
+		},
+	})
+	return instrumented
+}
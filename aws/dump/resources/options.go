@@ -0,0 +1,157 @@
+package resources
+
+import (
+	"strings"
+	"time"
+)
+
+type DumpOptions struct {
+	// Maximum number of concurrent API calls made within a single report.
+	Concurrency int
+
+	ThrottleMaxRetries int
+	ThrottleBaseDelay  time.Duration
+	ThrottleMaxDelay   time.Duration
+
+	LastAccessedPollInterval time.Duration
+	LastAccessedMaxWait      time.Duration
+	// SkipLastAccessed disables the service last accessed details of the IAM
+	// reports, generating them takes most of their time.
+	SkipLastAccessed bool
+
+	// Limits applied by the reports listing potentially huge numbers of
+	// resources, 0 means unlimited.
+	MaxItems int
+	MaxPages int
+
+	// Deterministic sorts the resources of each report so dumps can be
+	// diffed between runs.
+	Deterministic bool
+
+	// Access keys not used for longer than this are reported as stale.
+	StaleAccessKeyThreshold time.Duration
+
+	// PolicyScope selects the policies listed by the IAM policies report,
+	// one of Local, AWS or All.
+	PolicyScope string
+	// PolicyReferencedOnly skips the AWS managed policies that are not
+	// attached to any user, group or role.
+	PolicyReferencedOnly bool
+
+	// Logger gets progress messages, nothing is logged when nil.
+	Logger Logger
+
+	// Progress is called periodically by long reports with the number of
+	// resources found so far. It can be called from several goroutines at
+	// once and is called synchronously, so it must return quickly.
+	Progress func(service, report string, resourcesSoFar int)
+}
+
+func DefaultDumpOptions() *DumpOptions {
+	return &DumpOptions{
+		Concurrency:              10,
+		ThrottleMaxRetries:       8,
+		ThrottleBaseDelay:        200 * time.Millisecond,
+		ThrottleMaxDelay:         20 * time.Second,
+		LastAccessedPollInterval: 1 * time.Second,
+		LastAccessedMaxWait:      2 * time.Minute,
+		StaleAccessKeyThreshold:  90 * 24 * time.Hour,
+		PolicyScope:              "Local",
+	}
+}
+
+// options returns the session options, with the defaults in place of the
+// fields left to zero so partially filled options can be used.
+func (s *Session) options() *DumpOptions {
+	defaults := DefaultDumpOptions()
+	if s.Options == nil {
+		return defaults
+	}
+
+	options := *s.Options
+	if options.Concurrency < 1 {
+		options.Concurrency = defaults.Concurrency
+	}
+	if options.ThrottleMaxRetries == 0 {
+		options.ThrottleMaxRetries = defaults.ThrottleMaxRetries
+	}
+	if options.ThrottleBaseDelay == 0 {
+		options.ThrottleBaseDelay = defaults.ThrottleBaseDelay
+	}
+	if options.ThrottleMaxDelay == 0 {
+		options.ThrottleMaxDelay = defaults.ThrottleMaxDelay
+	}
+	if options.LastAccessedPollInterval == 0 {
+		options.LastAccessedPollInterval = defaults.LastAccessedPollInterval
+	}
+	if options.LastAccessedMaxWait == 0 {
+		options.LastAccessedMaxWait = defaults.LastAccessedMaxWait
+	}
+	if options.StaleAccessKeyThreshold == 0 {
+		options.StaleAccessKeyThreshold = defaults.StaleAccessKeyThreshold
+	}
+	if options.PolicyScope == "" {
+		options.PolicyScope = defaults.PolicyScope
+	}
+	return &options
+}
+
+func (s *Session) progress(resourcesSoFar int) {
+	progress := s.options().Progress
+	if progress == nil {
+		return
+	}
+
+	service, report := "", s.reportName
+	if parts := strings.SplitN(s.reportName, ":", 2); len(parts) == 2 {
+		service, report = parts[0], parts[1]
+	}
+	progress(service, report, resourcesSoFar)
+}
+
+type pageLimiter struct {
+	maxItems  int
+	maxPages  int
+	items     int
+	pages     int
+	truncated bool
+}
+
+func (s *Session) pageLimiter() *pageLimiter {
+	options := s.options()
+	return &pageLimiter{
+		maxItems: options.MaxItems,
+		maxPages: options.MaxPages,
+	}
+}
+
+// Item must be called before processing each item, it returns false once
+// the limit is reached.
+func (l *pageLimiter) Item() bool {
+	if l.maxItems > 0 && l.items >= l.maxItems {
+		l.truncated = true
+		return false
+	}
+	l.items++
+	return true
+}
+
+// Page must be called once a page has been processed, its result can be
+// returned from the page callback.
+func (l *pageLimiter) Page(lastPage bool) bool {
+	l.pages++
+	if l.maxPages > 0 && l.pages >= l.maxPages && !lastPage {
+		l.truncated = true
+		return false
+	}
+	return true
+}
+
+// Exhausted reports whether no more pages should be fetched, for reports
+// listing several collections with the same limiter.
+func (l *pageLimiter) Exhausted() bool {
+	if l.maxPages > 0 && l.pages >= l.maxPages {
+		l.truncated = true
+	}
+	return l.truncated
+}
@@ -0,0 +1,139 @@
+package resources
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+)
+
+var (
+	OrganizationsService = Service{
+		Name:     "organizations",
+		IsGlobal: true,
+		Reports: map[string]Report{
+			"accounts": OrgListAccounts,
+		},
+	}
+)
+
+func init() {
+	RegisterService(OrganizationsService)
+}
+
+func OrgListAccounts(ctx context.Context, session *Session) *ReportResult {
+	client := session.OrganizationsClient()
+	paths := &orgPathResolver{client: client, names: map[string]string{}, parents: map[string]string{}}
+
+	result := &ReportResult{}
+	err := client.ListAccountsPagesWithContext(ctx, &organizations.ListAccountsInput{},
+		func(page *organizations.ListAccountsOutput, lastPage bool) bool {
+			for _, account := range page.Accounts {
+				resource, err := NewResource(*account.Arn, account)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.ID = *account.Id
+				resource.Type = "account"
+				resource.Metadata["OrganizationsEnabled"] = true
+
+				path, err := paths.resolve(ctx, *account.Id)
+				if err != nil {
+					result.AddError(err)
+					resource.Metadata["Error"] = err.Error()
+				} else {
+					resource.Metadata["OUPath"] = path
+				}
+
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
+		})
+
+	if IsAWSErrorCode(err, organizations.ErrCodeAWSOrganizationsNotInUseException) {
+		return &ReportResult{Resources: []Resource{
+			{
+				ID:        session.AccountID,
+				AccountID: session.AccountID,
+				Service:   "organizations",
+				Type:      "account",
+				Metadata: map[string]interface{}{
+					"OrganizationsEnabled": false,
+				},
+			},
+		}}
+	}
+
+	result.AddError(err)
+	return result
+}
+
+type orgPathResolver struct {
+	client  *organizations.Organizations
+	names   map[string]string
+	parents map[string]string
+}
+
+// resolve returns the names of the organizational units from the root down
+// to the account, joined with /.
+func (r *orgPathResolver) resolve(ctx context.Context, accountID string) (string, error) {
+	names := []string{}
+	id := accountID
+	for {
+		parent, err := r.parent(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		if parent == "" {
+			break
+		}
+
+		name, err := r.name(ctx, parent)
+		if err != nil {
+			return "", err
+		}
+		names = append([]string{name}, names...)
+		id = parent
+	}
+	return strings.Join(names, "/"), nil
+}
+
+func (r *orgPathResolver) parent(ctx context.Context, id string) (string, error) {
+	if strings.HasPrefix(id, "r-") {
+		return "", nil
+	}
+	if parent, ok := r.parents[id]; ok {
+		return parent, nil
+	}
+
+	parents, err := r.client.ListParentsWithContext(ctx, &organizations.ListParentsInput{ChildId: aws.String(id)})
+	if err != nil {
+		return "", err
+	}
+	parent := ""
+	if len(parents.Parents) > 0 {
+		parent = *parents.Parents[0].Id
+	}
+	r.parents[id] = parent
+	return parent, nil
+}
+
+func (r *orgPathResolver) name(ctx context.Context, id string) (string, error) {
+	if strings.HasPrefix(id, "r-") {
+		return "Root", nil
+	}
+	if name, ok := r.names[id]; ok {
+		return name, nil
+	}
+
+	unit, err := r.client.DescribeOrganizationalUnitWithContext(ctx, &organizations.DescribeOrganizationalUnitInput{
+		OrganizationalUnitId: aws.String(id),
+	})
+	if err != nil {
+		return "", err
+	}
+	r.names[id] = *unit.OrganizationalUnit.Name
+	return r.names[id], nil
+}
@@ -0,0 +1,203 @@
+package resources
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/fatih/structs"
+	"gopkg.in/yaml.v3"
+)
+
+func NormalizeValue(value interface{}) interface{} {
+	return normalizeValue(reflect.ValueOf(value))
+}
+
+func normalizeValue(value reflect.Value) interface{} {
+	if !value.IsValid() {
+		return nil
+	}
+
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+		return normalizeValue(value.Elem())
+	case reflect.Map:
+		if value.IsNil() {
+			return nil
+		}
+		normalized := make(map[string]interface{}, value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			normalized[fmt.Sprint(iter.Key().Interface())] = normalizeValue(iter.Value())
+		}
+		return normalized
+	case reflect.Slice:
+		if value.IsNil() {
+			return nil
+		}
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			return value.Interface()
+		}
+		fallthrough
+	case reflect.Array:
+		normalized := make([]interface{}, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			normalized[i] = normalizeValue(value.Index(i))
+		}
+		return normalized
+	case reflect.Struct:
+		if t, ok := value.Interface().(time.Time); ok {
+			return t
+		}
+		return normalizeValue(reflect.ValueOf(structs.Map(value.Interface())))
+	}
+
+	return value.Interface()
+}
+
+func (r Resource) Normalized() Resource {
+	normalized := r
+	if r.Metadata != nil {
+		normalized.Metadata = NormalizeValue(r.Metadata).(map[string]interface{})
+	}
+	return normalized
+}
+
+func (r *ReportResult) MarshalJSON() ([]byte, error) {
+	resources := make([]Resource, 0, len(r.Resources))
+	for _, resource := range r.Resources {
+		resources = append(resources, resource.Normalized())
+	}
+	return json.Marshal(resources)
+}
+
+func (r *ReportResult) WriteJSON(w io.Writer) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// WriteYAML writes the resources as a YAML list. The resources go through the
+// JSON encoding first so both outputs hold the same values.
+func WriteYAML(w io.Writer, result *ReportResult) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var resources interface{}
+	err = decoder.Decode(&resources)
+	if err != nil {
+		return err
+	}
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	err = encoder.Encode(yamlNumbers(resources))
+	if err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// yamlNumbers turns json.Number values back into numbers, yaml would
+// otherwise write them as strings.
+func yamlNumbers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = yamlNumbers(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = yamlNumbers(item)
+		}
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+		return v.String()
+	}
+	return value
+}
+
+func lookupPath(metadata map[string]interface{}, path string) (interface{}, bool) {
+	var current interface{} = metadata
+	for _, key := range strings.Split(path, ".") {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		current, ok = m[key]
+		if !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+func formatCell(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case time.Time:
+		return v.Format(time.RFC3339), nil
+	case map[string]interface{}, []interface{}:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	}
+	return fmt.Sprint(value), nil
+}
+
+func WriteCSV(w io.Writer, result *ReportResult, columns []string) error {
+	writer := csv.NewWriter(w)
+
+	header := append([]string{"ID", "ARN", "Type"}, columns...)
+	err := writer.Write(header)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range result.Resources {
+		metadata, _ := NormalizeValue(resource.Metadata).(map[string]interface{})
+
+		row := []string{resource.ID, resource.ARN, resource.Type}
+		for _, column := range columns {
+			value, _ := lookupPath(metadata, column)
+			cell, err := formatCell(value)
+			if err != nil {
+				return err
+			}
+			row = append(row, cell)
+		}
+
+		err := writer.Write(row)
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
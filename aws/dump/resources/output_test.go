@@ -0,0 +1,116 @@
+package resources
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportResultJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	var lastUsed *time.Time
+	created := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	result := &ReportResult{
+		Resources: []Resource{
+			{
+				ID:        "AKIAEXAMPLE",
+				ARN:       "arn:aws:iam::123456789012:user/test",
+				AccountID: "123456789012",
+				Service:   "iam",
+				Type:      "access-key",
+				Region:    "us-east-1",
+				Metadata: map[string]interface{}{
+					"LastUsed":   lastUsed,
+					"CreateDate": &created,
+					"UserName":   aws.String("test"),
+					"Status":     (*string)(nil),
+				},
+			},
+		},
+	}
+
+	buffer := &bytes.Buffer{}
+	require.NoError(t, result.WriteJSON(buffer))
+
+	decoded := []Resource{}
+	require.NoError(t, json.Unmarshal(buffer.Bytes(), &decoded))
+	require.Len(t, decoded, 1)
+
+	resource := decoded[0]
+	require.Equal(t, "AKIAEXAMPLE", resource.ID)
+	require.Equal(t, "arn:aws:iam::123456789012:user/test", resource.ARN)
+	require.Equal(t, "123456789012", resource.AccountID)
+	require.Equal(t, "iam", resource.Service)
+	require.Equal(t, "access-key", resource.Type)
+	require.Equal(t, "us-east-1", resource.Region)
+
+	require.Contains(t, resource.Metadata, "LastUsed")
+	require.Nil(t, resource.Metadata["LastUsed"])
+	require.Nil(t, resource.Metadata["Status"])
+	require.Equal(t, "test", resource.Metadata["UserName"])
+	require.Equal(t, "2020-01-02T03:04:05Z", resource.Metadata["CreateDate"])
+}
+
+func TestGzipSinkProducesNDJSON(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	sink := NewGzipSink(buffer)
+
+	require.NoError(t, sink.Emit(Resource{ID: "a", ARN: "arn:aws:s3:::a"}))
+	require.NoError(t, sink.Emit(Resource{ID: "b", ARN: "arn:aws:s3:::b"}))
+	// nothing but the gzip header is written until the sink is closed
+	require.LessOrEqual(t, buffer.Len(), 10)
+	require.NoError(t, sink.Close())
+
+	reader, err := gzip.NewReader(buffer)
+	require.NoError(t, err)
+
+	scanner := bufio.NewScanner(reader)
+	ids := []string{}
+	for scanner.Scan() {
+		decoded := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &decoded))
+		ids = append(ids, decoded["id"].(string))
+	}
+	require.NoError(t, scanner.Err())
+	require.Equal(t, []string{"a", "b"}, ids)
+}
+
+func TestWriteYAML(t *testing.T) {
+	t.Parallel()
+
+	var lastUsed *time.Time
+	created := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	result := &ReportResult{
+		Resources: []Resource{
+			{
+				ID:  "user",
+				ARN: "arn:aws:iam::123456789012:user/user",
+				Metadata: map[string]interface{}{
+					"UserName":   aws.String("user"),
+					"CreateDate": &created,
+					"LastUsed":   lastUsed,
+					"Count":      aws.Int64(3),
+				},
+			},
+		},
+	}
+
+	buffer := &bytes.Buffer{}
+	require.NoError(t, WriteYAML(buffer, result))
+
+	output := buffer.String()
+	require.Contains(t, output, "- account_id: \"\"\n")
+	require.Contains(t, output, "    Count: 3\n")
+	require.Contains(t, output, "    CreateDate: \"2020-01-02T03:04:05Z\"\n")
+	require.Contains(t, output, "    LastUsed: null\n")
+	require.Contains(t, output, "    UserName: user\n")
+	require.Less(t, strings.Index(output, "Count"), strings.Index(output, "UserName"))
+}
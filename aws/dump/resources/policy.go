@@ -0,0 +1,140 @@
+package resources
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+type PolicyDocument struct {
+	Version   string
+	Statement []Statement
+}
+
+type Statement struct {
+	Sid         string `json:",omitempty"`
+	Effect      string
+	Action      []string               `json:",omitempty"`
+	NotAction   []string               `json:",omitempty"`
+	Resource    []string               `json:",omitempty"`
+	NotResource []string               `json:",omitempty"`
+	Principal   map[string][]string    `json:",omitempty"`
+	Condition   map[string]interface{} `json:",omitempty"`
+}
+
+// stringOrList accepts both "value" and ["value", ...] in policy documents.
+type stringOrList []string
+
+func (s *stringOrList) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*s = []string{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*s = list
+	return nil
+}
+
+// principal accepts "*" as well as {"AWS": "..."} or {"AWS": [...]}. "*" is
+// stored as {"AWS": ["*"]} which is how AWS interprets it.
+type principal map[string][]string
+
+func (p *principal) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*p = principal{"AWS": []string{single}}
+		return nil
+	}
+	raw := map[string]stringOrList{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*p = principal{}
+	for key, values := range raw {
+		(*p)[key] = values
+	}
+	return nil
+}
+
+type rawStatement struct {
+	Sid         string
+	Effect      string
+	Action      stringOrList
+	NotAction   stringOrList
+	Resource    stringOrList
+	NotResource stringOrList
+	Principal   principal
+	Condition   map[string]interface{}
+}
+
+// ParsePolicyDocument is a typed alternative to DecodeInlinePolicyDocument.
+// The document can be URL encoded like the ones returned by IAM.
+func ParsePolicyDocument(encoded string) (*PolicyDocument, error) {
+	decodedDocument, err := url.QueryUnescape(encoded)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode policy document")
+	}
+	return parsePolicyJSON([]byte(decodedDocument))
+}
+
+func parsePolicyJSON(data []byte) (*PolicyDocument, error) {
+	raw := struct {
+		Version   string
+		Statement json.RawMessage
+	}{}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse policy document to JSON")
+	}
+
+	statements := []rawStatement{}
+	if len(raw.Statement) > 0 {
+		// Statement can be a single object instead of a list
+		if raw.Statement[0] == '{' {
+			statement := rawStatement{}
+			err = json.Unmarshal(raw.Statement, &statement)
+			statements = append(statements, statement)
+		} else {
+			err = json.Unmarshal(raw.Statement, &statements)
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse policy statements")
+		}
+	}
+
+	document := &PolicyDocument{Version: raw.Version, Statement: []Statement{}}
+	for _, statement := range statements {
+		document.Statement = append(document.Statement, Statement{
+			Sid:         statement.Sid,
+			Effect:      statement.Effect,
+			Action:      statement.Action,
+			NotAction:   statement.NotAction,
+			Resource:    statement.Resource,
+			NotResource: statement.NotResource,
+			Principal:   statement.Principal,
+			Condition:   statement.Condition,
+		})
+	}
+	return document, nil
+}
+
+// AdminStatements returns the statements allowing every action on every
+// resource.
+func (d *PolicyDocument) AdminStatements() []Statement {
+	statements := []Statement{}
+	for _, statement := range d.Statement {
+		if statement.Effect != "Allow" {
+			continue
+		}
+		if (containsString(statement.Action, "*") || containsString(statement.Action, "*:*")) &&
+			containsString(statement.Resource, "*") {
+			statements = append(statements, statement)
+		}
+	}
+	return statements
+}
@@ -0,0 +1,59 @@
+package resources
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	document, err := ParsePolicyDocument(url.QueryEscape(`{
+		"Version": "2012-10-17",
+		"Statement": [
+			{"Effect": "Allow", "Action": "s3:GetObject", "Resource": ["arn:aws:s3:::a/*", "arn:aws:s3:::b/*"], "Principal": "*"},
+			{"Effect": "Deny", "Action": ["s3:*"], "Resource": "*", "Principal": {"AWS": "arn:aws:iam::123456789012:root", "Service": ["ec2.amazonaws.com"]},
+			 "Condition": {"Bool": {"aws:SecureTransport": "false"}}}
+		]
+	}`))
+	require.NoError(t, err)
+	require.Equal(t, "2012-10-17", document.Version)
+	require.Len(t, document.Statement, 2)
+
+	require.Equal(t, []string{"s3:GetObject"}, document.Statement[0].Action)
+	require.Equal(t, []string{"arn:aws:s3:::a/*", "arn:aws:s3:::b/*"}, document.Statement[0].Resource)
+	require.Equal(t, map[string][]string{"AWS": {"*"}}, document.Statement[0].Principal)
+
+	require.Equal(t, "Deny", document.Statement[1].Effect)
+	require.Equal(t, []string{"*"}, document.Statement[1].Resource)
+	require.Equal(t, map[string][]string{
+		"AWS":     {"arn:aws:iam::123456789012:root"},
+		"Service": {"ec2.amazonaws.com"},
+	}, document.Statement[1].Principal)
+	require.Contains(t, document.Statement[1].Condition, "Bool")
+
+	single, err := ParsePolicyDocument(`{"Statement": {"Effect": "Allow", "Action": "*", "Resource": "*"}}`)
+	require.NoError(t, err)
+	require.Len(t, single.Statement, 1)
+	require.Equal(t, []string{"*"}, single.Statement[0].Action)
+}
+
+func TestPolicyDocumentAdminStatements(t *testing.T) {
+	t.Parallel()
+
+	document, err := ParsePolicyDocument(`{"Statement": [
+		{"Effect": "Allow", "Action": "*", "Resource": "*"},
+		{"Effect": "Allow", "Action": ["*:*"], "Resource": ["*"]},
+		{"Effect": "Deny", "Action": "*", "Resource": "*"},
+		{"Effect": "Allow", "Action": "s3:*", "Resource": "*"},
+		{"Effect": "Allow", "Action": "*", "Resource": "arn:aws:s3:::bucket"}
+	]}`)
+	require.NoError(t, err)
+
+	admin := document.AdminStatements()
+	require.Len(t, admin, 2)
+	require.Equal(t, []string{"*"}, admin[0].Action)
+	require.Equal(t, []string{"*:*"}, admin[1].Action)
+}
@@ -1,8 +1,10 @@
 package resources
 
 import (
+	"context"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/fatih/structs"
 )
@@ -27,12 +29,35 @@ var (
 	}
 )
 
-func RDSListDBClusters(session *Session) *ReportResult {
+func init() {
+	RegisterService(RDSService)
+}
 
-	client := rds.New(session.Session, session.Config)
+func RDSTagsToMap(tags []*rds.Tag) map[string]string {
+	tagsMap := map[string]string{}
+	for _, tag := range tags {
+		if tag.Key == nil {
+			continue
+		}
+		tagsMap[*tag.Key] = aws.StringValue(tag.Value)
+	}
+	return tagsMap
+}
+
+func rdsVpcSecurityGroupIds(groups []*rds.VpcSecurityGroupMembership) []string {
+	ids := []string{}
+	for _, group := range groups {
+		ids = append(ids, *group.VpcSecurityGroupId)
+	}
+	return ids
+}
+
+func RDSListDBClusters(ctx context.Context, session *Session) *ReportResult {
+
+	client := session.RDSClient()
 
 	resources := []Resource{}
-	err := client.DescribeDBClustersPages(&rds.DescribeDBClustersInput{},
+	err := client.DescribeDBClustersPagesWithContext(ctx, &rds.DescribeDBClustersInput{},
 		func(page *rds.DescribeDBClustersOutput, lastPage bool) bool {
 			for _, resource := range page.DBClusters {
 				r := Resource{
@@ -44,21 +69,23 @@ func RDSListDBClusters(session *Session) *ReportResult {
 					Region:    *session.Config.Region,
 					Metadata:  structs.Map(resource),
 				}
+				r.Metadata["SecurityGroups"] = rdsVpcSecurityGroupIds(resource.VpcSecurityGroups)
+				r.Metadata["TagsMap"] = RDSTagsToMap(resource.TagList)
 				resources = append(resources, r)
 			}
 
 			return true
 		})
 
-	return &ReportResult{resources, err}
+	return &ReportResult{Resources: resources, Error: err}
 }
 
-func RDSListDBInstanceAutomatedBackups(session *Session) *ReportResult {
+func RDSListDBInstanceAutomatedBackups(ctx context.Context, session *Session) *ReportResult {
 
-	client := rds.New(session.Session, session.Config)
+	client := session.RDSClient()
 
 	resources := []Resource{}
-	err := client.DescribeDBInstanceAutomatedBackupsPages(&rds.DescribeDBInstanceAutomatedBackupsInput{},
+	err := client.DescribeDBInstanceAutomatedBackupsPagesWithContext(ctx, &rds.DescribeDBInstanceAutomatedBackupsInput{},
 		func(page *rds.DescribeDBInstanceAutomatedBackupsOutput, lastPage bool) bool {
 			for _, resource := range page.DBInstanceAutomatedBackups {
 				r := Resource{
@@ -76,15 +103,15 @@ func RDSListDBInstanceAutomatedBackups(session *Session) *ReportResult {
 			return true
 		})
 
-	return &ReportResult{resources, err}
+	return &ReportResult{Resources: resources, Error: err}
 }
 
-func RDSListDBInstances(session *Session) *ReportResult {
+func RDSListDBInstances(ctx context.Context, session *Session) *ReportResult {
 
-	client := rds.New(session.Session, session.Config)
+	client := session.RDSClient()
 
 	resources := []Resource{}
-	err := client.DescribeDBInstancesPages(&rds.DescribeDBInstancesInput{},
+	err := client.DescribeDBInstancesPagesWithContext(ctx, &rds.DescribeDBInstancesInput{},
 		func(page *rds.DescribeDBInstancesOutput, lastPage bool) bool {
 			for _, resource := range page.DBInstances {
 				r := Resource{
@@ -96,21 +123,27 @@ func RDSListDBInstances(session *Session) *ReportResult {
 					Region:    *session.Config.Region,
 					Metadata:  structs.Map(resource),
 				}
+				securityGroups := rdsVpcSecurityGroupIds(resource.VpcSecurityGroups)
+				for _, group := range resource.DBSecurityGroups {
+					securityGroups = append(securityGroups, *group.DBSecurityGroupName)
+				}
+				r.Metadata["SecurityGroups"] = securityGroups
+				r.Metadata["TagsMap"] = RDSTagsToMap(resource.TagList)
 				resources = append(resources, r)
 			}
 
 			return true
 		})
 
-	return &ReportResult{resources, err}
+	return &ReportResult{Resources: resources, Error: err}
 }
 
-func RDSListDBParameterGroups(session *Session) *ReportResult {
+func RDSListDBParameterGroups(ctx context.Context, session *Session) *ReportResult {
 
-	client := rds.New(session.Session, session.Config)
+	client := session.RDSClient()
 
 	resources := []Resource{}
-	err := client.DescribeDBParameterGroupsPages(&rds.DescribeDBParameterGroupsInput{},
+	err := client.DescribeDBParameterGroupsPagesWithContext(ctx, &rds.DescribeDBParameterGroupsInput{},
 		func(page *rds.DescribeDBParameterGroupsOutput, lastPage bool) bool {
 			for _, resource := range page.DBParameterGroups {
 				r := Resource{
@@ -128,15 +161,15 @@ func RDSListDBParameterGroups(session *Session) *ReportResult {
 			return true
 		})
 
-	return &ReportResult{resources, err}
+	return &ReportResult{Resources: resources, Error: err}
 }
 
-func RDSListDBSecurityGroups(session *Session) *ReportResult {
+func RDSListDBSecurityGroups(ctx context.Context, session *Session) *ReportResult {
 
-	client := rds.New(session.Session, session.Config)
+	client := session.RDSClient()
 
 	resources := []Resource{}
-	err := client.DescribeDBSecurityGroupsPages(&rds.DescribeDBSecurityGroupsInput{},
+	err := client.DescribeDBSecurityGroupsPagesWithContext(ctx, &rds.DescribeDBSecurityGroupsInput{},
 		func(page *rds.DescribeDBSecurityGroupsOutput, lastPage bool) bool {
 			for _, resource := range page.DBSecurityGroups {
 				r := Resource{
@@ -154,15 +187,15 @@ func RDSListDBSecurityGroups(session *Session) *ReportResult {
 			return true
 		})
 
-	return &ReportResult{resources, err}
+	return &ReportResult{Resources: resources, Error: err}
 }
 
-func RDSListDBSnapshots(session *Session) *ReportResult {
+func RDSListDBSnapshots(ctx context.Context, session *Session) *ReportResult {
 
-	client := rds.New(session.Session, session.Config)
+	client := session.RDSClient()
 
 	resources := []Resource{}
-	err := client.DescribeDBSnapshotsPages(&rds.DescribeDBSnapshotsInput{},
+	err := client.DescribeDBSnapshotsPagesWithContext(ctx, &rds.DescribeDBSnapshotsInput{},
 		func(page *rds.DescribeDBSnapshotsOutput, lastPage bool) bool {
 			for _, resource := range page.DBSnapshots {
 				r := Resource{
@@ -180,15 +213,15 @@ func RDSListDBSnapshots(session *Session) *ReportResult {
 			return true
 		})
 
-	return &ReportResult{resources, err}
+	return &ReportResult{Resources: resources, Error: err}
 }
 
-func RDSListDBSubnetGroups(session *Session) *ReportResult {
+func RDSListDBSubnetGroups(ctx context.Context, session *Session) *ReportResult {
 
-	client := rds.New(session.Session, session.Config)
+	client := session.RDSClient()
 
 	resources := []Resource{}
-	err := client.DescribeDBSubnetGroupsPages(&rds.DescribeDBSubnetGroupsInput{},
+	err := client.DescribeDBSubnetGroupsPagesWithContext(ctx, &rds.DescribeDBSubnetGroupsInput{},
 		func(page *rds.DescribeDBSubnetGroupsOutput, lastPage bool) bool {
 			for _, resource := range page.DBSubnetGroups {
 				r := Resource{
@@ -206,15 +239,15 @@ func RDSListDBSubnetGroups(session *Session) *ReportResult {
 			return true
 		})
 
-	return &ReportResult{resources, err}
+	return &ReportResult{Resources: resources, Error: err}
 }
 
-func RDSListEventSubscriptions(session *Session) *ReportResult {
+func RDSListEventSubscriptions(ctx context.Context, session *Session) *ReportResult {
 
-	client := rds.New(session.Session, session.Config)
+	client := session.RDSClient()
 
 	resources := []Resource{}
-	err := client.DescribeEventSubscriptionsPages(&rds.DescribeEventSubscriptionsInput{},
+	err := client.DescribeEventSubscriptionsPagesWithContext(ctx, &rds.DescribeEventSubscriptionsInput{},
 		func(page *rds.DescribeEventSubscriptionsOutput, lastPage bool) bool {
 			for _, resource := range page.EventSubscriptionsList {
 				r := Resource{
@@ -232,15 +265,15 @@ func RDSListEventSubscriptions(session *Session) *ReportResult {
 			return true
 		})
 
-	return &ReportResult{resources, err}
+	return &ReportResult{Resources: resources, Error: err}
 }
 
-func RDSListEvents(session *Session) *ReportResult {
+func RDSListEvents(ctx context.Context, session *Session) *ReportResult {
 
-	client := rds.New(session.Session, session.Config)
+	client := session.RDSClient()
 
 	resources := []Resource{}
-	err := client.DescribeEventsPages(&rds.DescribeEventsInput{},
+	err := client.DescribeEventsPagesWithContext(ctx, &rds.DescribeEventsInput{},
 		func(page *rds.DescribeEventsOutput, lastPage bool) bool {
 			for _, resource := range page.Events {
 				r := Resource{
@@ -258,15 +291,15 @@ func RDSListEvents(session *Session) *ReportResult {
 			return true
 		})
 
-	return &ReportResult{resources, err}
+	return &ReportResult{Resources: resources, Error: err}
 }
 
-func RDSListGlobalClusters(session *Session) *ReportResult {
+func RDSListGlobalClusters(ctx context.Context, session *Session) *ReportResult {
 
-	client := rds.New(session.Session, session.Config)
+	client := session.RDSClient()
 
 	resources := []Resource{}
-	err := client.DescribeGlobalClustersPages(&rds.DescribeGlobalClustersInput{},
+	err := client.DescribeGlobalClustersPagesWithContext(ctx, &rds.DescribeGlobalClustersInput{},
 		func(page *rds.DescribeGlobalClustersOutput, lastPage bool) bool {
 			for _, resource := range page.GlobalClusters {
 				r := Resource{
@@ -284,15 +317,15 @@ func RDSListGlobalClusters(session *Session) *ReportResult {
 			return true
 		})
 
-	return &ReportResult{resources, err}
+	return &ReportResult{Resources: resources, Error: err}
 }
 
-func RDSListOptionGroups(session *Session) *ReportResult {
+func RDSListOptionGroups(ctx context.Context, session *Session) *ReportResult {
 
-	client := rds.New(session.Session, session.Config)
+	client := session.RDSClient()
 
 	resources := []Resource{}
-	err := client.DescribeOptionGroupsPages(&rds.DescribeOptionGroupsInput{},
+	err := client.DescribeOptionGroupsPagesWithContext(ctx, &rds.DescribeOptionGroupsInput{},
 		func(page *rds.DescribeOptionGroupsOutput, lastPage bool) bool {
 			for _, resource := range page.OptionGroupsList {
 				r := Resource{
@@ -313,15 +346,15 @@ func RDSListOptionGroups(session *Session) *ReportResult {
 			return true
 		})
 
-	return &ReportResult{resources, err}
+	return &ReportResult{Resources: resources, Error: err}
 }
 
-func RDSListReservedDBInstances(session *Session) *ReportResult {
+func RDSListReservedDBInstances(ctx context.Context, session *Session) *ReportResult {
 
-	client := rds.New(session.Session, session.Config)
+	client := session.RDSClient()
 
 	resources := []Resource{}
-	err := client.DescribeReservedDBInstancesPages(&rds.DescribeReservedDBInstancesInput{},
+	err := client.DescribeReservedDBInstancesPagesWithContext(ctx, &rds.DescribeReservedDBInstancesInput{},
 		func(page *rds.DescribeReservedDBInstancesOutput, lastPage bool) bool {
 			for _, resource := range page.ReservedDBInstances {
 				r := Resource{
@@ -339,5 +372,5 @@ func RDSListReservedDBInstances(session *Session) *ReportResult {
 			return true
 		})
 
-	return &ReportResult{resources, err}
+	return &ReportResult{Resources: resources, Error: err}
 }
@@ -0,0 +1,82 @@
+package resources
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+type Redactor func(service, field string, value interface{}) interface{}
+
+const RedactedValue = "REDACTED"
+
+var (
+	// passwords, credentials and tokens are only matched at the end of the
+	// name so fields about them like password_last_used, the EC2
+	// MetadataOptions.HttpTokens or ServiceSpecificCredentialId are kept
+	secretFieldPattern  = regexp.MustCompile(`(?i)(secret|private_?key|api_?key|(password|passwd|credentials?)$|(^|[_-]|session|access|auth|refresh|bearer|api|id)_?token$)`)
+	secretValuePatterns = []*regexp.Regexp{
+		regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----`),
+		regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9]{36,}\b`),
+		regexp.MustCompile(`\bxox[abposr]-[A-Za-z0-9-]{10,}\b`),
+		regexp.MustCompile(`\beyJ[A-Za-z0-9_-]+\.eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`),
+		regexp.MustCompile(`(?i)aws_secret_access_key\s*[=:]\s*[A-Za-z0-9/+]{40}`),
+	}
+)
+
+// RedactSecrets masks string values stored under secret looking field names
+// or containing well known credential formats.
+func RedactSecrets(service, field string, value interface{}) interface{} {
+	str, ok := value.(string)
+	if !ok {
+		return value
+	}
+
+	name := field
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	if secretFieldPattern.MatchString(name) {
+		return RedactedValue
+	}
+
+	for _, pattern := range secretValuePatterns {
+		if pattern.MatchString(str) {
+			return RedactedValue
+		}
+	}
+	return value
+}
+
+func (r *ReportResult) Redact(redactor Redactor) {
+	for i := range r.Resources {
+		resource := &r.Resources[i]
+		if resource.Metadata == nil {
+			continue
+		}
+		metadata := NormalizeValue(resource.Metadata).(map[string]interface{})
+		resource.Metadata = redactValue(redactor, resource.Service, "", metadata).(map[string]interface{})
+	}
+}
+
+func redactValue(redactor Redactor, service, path string, value interface{}) interface{} {
+	if path != "" {
+		value = redactor(service, path, value)
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(v))
+		for key, child := range v {
+			redacted[key] = redactValue(redactor, service, joinPath(path, key), child)
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(v))
+		for i, child := range v {
+			redacted[i] = redactValue(redactor, service, fmt.Sprintf("%s[%d]", path, i), child)
+		}
+		return redacted
+	}
+	return value
+}
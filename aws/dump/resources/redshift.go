@@ -0,0 +1,78 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/fatih/structs"
+)
+
+var (
+	RedshiftService = Service{
+		Name: "redshift",
+		Reports: map[string]Report{
+			"clusters": RedshiftListClusters,
+		},
+	}
+)
+
+func init() {
+	RegisterService(RedshiftService)
+}
+
+func RedshiftListClusters(ctx context.Context, session *Session) *ReportResult {
+	client := session.RedshiftClient()
+
+	result := &ReportResult{}
+	err := client.DescribeClustersPagesWithContext(ctx, &redshift.DescribeClustersInput{},
+		func(page *redshift.DescribeClustersOutput, lastPage bool) bool {
+			for _, cluster := range page.Clusters {
+				arn := BuildARN(session.Partition(), "redshift",
+					*session.Config.Region,
+					session.AccountID,
+					fmt.Sprintf("cluster:%s", *cluster.ClusterIdentifier),
+				)
+				resource, err := NewResource(arn, cluster)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "cluster"
+				resource.Metadata["PubliclyAccessible"] = aws.BoolValue(cluster.PubliclyAccessible)
+
+				parameterGroups := []string{}
+				for _, group := range cluster.ClusterParameterGroups {
+					parameterGroups = append(parameterGroups, aws.StringValue(group.ParameterGroupName))
+				}
+				resource.Metadata["ParameterGroupNames"] = parameterGroups
+				resource.Metadata["TagsMap"] = RedshiftTagsToMap(cluster.Tags)
+
+				logging, err := client.DescribeLoggingStatusWithContext(ctx, &redshift.DescribeLoggingStatusInput{
+					ClusterIdentifier: cluster.ClusterIdentifier,
+				})
+				if err != nil {
+					result.AddError(err)
+					resource.Metadata["Error"] = err.Error()
+				} else {
+					resource.Metadata["LoggingStatus"] = structs.Map(logging)
+					resource.Metadata["LoggingEnabled"] = aws.BoolValue(logging.LoggingEnabled)
+				}
+
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
+
+func RedshiftTagsToMap(tags []*redshift.Tag) map[string]string {
+	tagsMap := map[string]string{}
+	for _, tag := range tags {
+		tagsMap[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return tagsMap
+}
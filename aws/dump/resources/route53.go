@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -11,66 +12,103 @@ import (
 
 var (
 	Route53Service = Service{
-		Name: "route53",
+		Name:     "route53",
+		IsGlobal: true,
 		Reports: map[string]Report{
-			"zones-and-records": Route53ListHostedZonesAndRecordSets,
+			"hosted-zones": Route53ListHostedZones,
+			"record-sets":  Route53ListRecordSets,
 		},
 	}
 )
 
-func Route53ListHostedZonesAndRecordSets(session *Session) *ReportResult {
-	client := route53.New(session.Session, session.Config)
+func init() {
+	RegisterService(Route53Service)
+}
+
+func route53ShortID(id string) string {
+	parts := strings.Split(id, "/")
+	return parts[len(parts)-1]
+}
+
+func Route53ListHostedZones(ctx context.Context, session *Session) *ReportResult {
+	client := session.Route53Client()
+
 	result := &ReportResult{}
-	result.Error = client.ListHostedZonesPages(&route53.ListHostedZonesInput{},
+	err := client.ListHostedZonesPagesWithContext(ctx, &route53.ListHostedZonesInput{},
 		func(page *route53.ListHostedZonesOutput, lastPage bool) bool {
 			for _, zone := range page.HostedZones {
-
-				parts := strings.Split(*zone.Id, "/")
-				shortID := parts[len(parts)-1]
+				shortID := route53ShortID(*zone.Id)
 
 				resource := &Resource{
 					ID:        shortID,
+					ARN:       BuildARN(session.Partition(), "route53", "", "", fmt.Sprintf("hostedzone/%s", shortID)),
 					AccountID: session.AccountID,
 					Service:   "route53",
 					Type:      "zone",
 					Metadata:  structs.Map(zone),
 				}
+				resource.Metadata["Name"] = strings.TrimRight(*zone.Name, ".")
+				resource.Metadata["Private"] = false
+				resource.Metadata["Comment"] = ""
+				if zone.Config != nil {
+					resource.Metadata["Private"] = aws.BoolValue(zone.Config.PrivateZone)
+					resource.Metadata["Comment"] = aws.StringValue(zone.Config.Comment)
+				}
 				result.Resources = append(result.Resources, *resource)
+			}
 
-				records := Route53ListResourceRecordSets(session, *zone.Id)
-				if records.Error != nil {
-					result.Error = records.Error
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
+
+// Record sets are emitted to the session sink as they are listed and only
+// accumulated in the result when no sink is configured.
+func Route53ListRecordSets(ctx context.Context, session *Session) *ReportResult {
+	client := session.Route53Client()
+
+	limiter := session.pageLimiter()
+	result := &ReportResult{}
+	err := client.ListHostedZonesPagesWithContext(ctx, &route53.ListHostedZonesInput{},
+		func(page *route53.ListHostedZonesOutput, lastPage bool) bool {
+			for _, zone := range page.HostedZones {
+				if limiter.Exhausted() {
 					return false
 				}
-				result.Resources = append(result.Resources, records.Resources...)
+				err := route53ListZoneRecordSets(ctx, session, client, *zone.Id, limiter, result)
+				if err != nil {
+					result.AddError(err)
+				}
 			}
-
 			return true
 		})
 
+	result.AddError(err)
+	result.Truncated = limiter.truncated
 	return result
 }
 
-func Route53ListResourceRecordSets(session *Session, hostedZoneID string) *ReportResult {
-	client := route53.New(session.Session, session.Config)
+func route53ListZoneRecordSets(ctx context.Context, session *Session, client *route53.Route53, hostedZoneID string, limiter *pageLimiter, result *ReportResult) error {
+	shortID := route53ShortID(hostedZoneID)
 
-	parts := strings.Split(hostedZoneID, "/")
-	shortID := parts[len(parts)-1]
-
-	result := &ReportResult{}
-	result.Error = client.ListResourceRecordSetsPages(&route53.ListResourceRecordSetsInput{HostedZoneId: aws.String(hostedZoneID)},
+	return client.ListResourceRecordSetsPagesWithContext(ctx, &route53.ListResourceRecordSetsInput{HostedZoneId: aws.String(hostedZoneID)},
 		func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
 			for _, set := range page.ResourceRecordSets {
 				if *set.Type == "NS" || *set.Type == "SOA" {
 					continue
 				}
+				if !limiter.Item() {
+					return false
+				}
 
 				records := []string{}
 				for _, record := range set.ResourceRecords {
 					records = append(records, *record.Value)
 				}
 
-				resource := &Resource{
+				resource := Resource{
 					ID:        fmt.Sprintf("%s_%s_%s", shortID, strings.TrimRight(*set.Name, "."), *set.Type),
 					AccountID: session.AccountID,
 					Service:   "route53",
@@ -90,11 +128,15 @@ func Route53ListResourceRecordSets(session *Session, hostedZoneID string) *Repor
 				if set.TTL != nil {
 					resource.Metadata["Ttl"] = fmt.Sprintf("%d", *set.TTL)
 				}
-				result.Resources = append(result.Resources, *resource)
+
+				if session.Sink == nil {
+					result.Resources = append(result.Resources, resource)
+				} else if err := session.Emit(resource); err != nil {
+					result.AddError(err)
+					return false
+				}
 			}
 
-			return true
+			return limiter.Page(lastPage)
 		})
-
-	return result
 }
@@ -1,8 +1,10 @@
 package resources
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/fatih/structs"
 )
@@ -16,64 +18,85 @@ var (
 	}
 )
 
-func S3ListBuckets(session *Session) *ReportResult {
-	client := s3.New(session.Session, session.Config)
+func init() {
+	RegisterService(S3Service)
+}
+
+func S3ListBuckets(ctx context.Context, session *Session) *ReportResult {
+	client := session.S3Client()
 
-	result := &ReportResult{[]Resource{}, nil}
-	res, err := client.ListBuckets(&s3.ListBucketsInput{})
+	result := &ReportResult{Resources: []Resource{}}
+	res, err := client.ListBucketsWithContext(ctx, &s3.ListBucketsInput{})
 	if err != nil {
-		return &ReportResult{nil, err}
+		return &ReportResult{Error: err}
 	}
 
 	for _, bucket := range res.Buckets {
 
-		location, err := client.GetBucketLocation(&s3.GetBucketLocationInput{
+		location, err := client.GetBucketLocationWithContext(ctx, &s3.GetBucketLocationInput{
 			Bucket: bucket.Name,
 		})
-
 		if err != nil {
-			result.Error = err
-			return result
+			// without its location the bucket can't be attributed to a region
+			result.AddError(fmt.Errorf("failed to get location of bucket %s: %w", *bucket.Name, err))
+			continue
 		}
 
-		if *location.LocationConstraint != *session.Config.Region {
+		region := s3.NormalizeBucketLocation(aws.StringValue(location.LocationConstraint))
+		if region != *session.Config.Region {
 			continue
 		}
 
-		result.Resources = append(result.Resources, Resource{
+		resource := Resource{
 			ID:        *bucket.Name,
-			ARN:       fmt.Sprintf("arn:aws:s3:::%s", *bucket.Name),
+			ARN:       BuildARN(session.Partition(), "s3", "", "", *bucket.Name),
 			AccountID: session.AccountID,
 			Service:   "s3",
 			Type:      "bucket",
-			Region:    *location.LocationConstraint,
+			Region:    region,
 			Metadata:  structs.Map(bucket),
-		})
+		}
 
-		policy, err := client.GetBucketPolicy(&s3.GetBucketPolicyInput{
+		policy, err := client.GetBucketPolicyWithContext(ctx, &s3.GetBucketPolicyInput{
 			Bucket: bucket.Name,
 		})
-		if err != nil {
-			result.Error = err
-			return result
+		resource.Metadata["Policy"] = nil
+		if err != nil && !IsAWSErrorCode(err, "NoSuchBucketPolicy") {
+			result.AddError(err)
+			resource.Metadata["PolicyError"] = err.Error()
+		} else if err == nil {
+			document, err := DecodeInlinePolicyDocument(*policy.Policy)
+			if err != nil {
+				result.AddError(err)
+				resource.Metadata["PolicyError"] = err.Error()
+			} else {
+				resource.Metadata["Policy"] = document
+			}
 		}
 
-		document, err := DecodeInlinePolicyDocument(*policy.Policy)
-		if err != nil {
-			result.Error = err
-			return result
+		publicAccessBlock, err := client.GetPublicAccessBlockWithContext(ctx, &s3.GetPublicAccessBlockInput{
+			Bucket: bucket.Name,
+		})
+		resource.Metadata["PublicAccessBlock"] = nil
+		if err != nil && !IsAWSErrorCode(err, "NoSuchPublicAccessBlockConfiguration") {
+			result.AddError(err)
+			resource.Metadata["PublicAccessBlockError"] = err.Error()
+		} else if err == nil {
+			resource.Metadata["PublicAccessBlock"] = structs.Map(publicAccessBlock.PublicAccessBlockConfiguration)
 		}
 
-		result.Resources = append(result.Resources, Resource{
-			ID:        *bucket.Name,
-			AccountID: session.AccountID,
-			Service:   "s3",
-			Type:      "bucket-policy",
-			Metadata: map[string]interface{}{
-				"PolicyDocument": document,
-			},
+		encryption, err := client.GetBucketEncryptionWithContext(ctx, &s3.GetBucketEncryptionInput{
+			Bucket: bucket.Name,
 		})
+		resource.Metadata["Encryption"] = nil
+		if err != nil && !IsAWSErrorCode(err, "ServerSideEncryptionConfigurationNotFoundError") {
+			result.AddError(err)
+			resource.Metadata["EncryptionError"] = err.Error()
+		} else if err == nil {
+			resource.Metadata["Encryption"] = structs.Map(encryption.ServerSideEncryptionConfiguration)
+		}
 
+		result.Resources = append(result.Resources, resource)
 	}
 
 	return result
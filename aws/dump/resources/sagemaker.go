@@ -0,0 +1,154 @@
+package resources
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/fatih/structs"
+)
+
+var (
+	SageMakerService = Service{
+		Name: "sagemaker",
+		Reports: map[string]Report{
+			"notebook-instances": SageMakerListNotebookInstances,
+			"endpoints":          SageMakerListEndpoints,
+		},
+	}
+)
+
+func init() {
+	RegisterService(SageMakerService)
+}
+
+func SageMakerListNotebookInstances(ctx context.Context, session *Session) *ReportResult {
+	client := session.SageMakerClient()
+
+	result := &ReportResult{}
+	err := client.ListNotebookInstancesPagesWithContext(ctx, &sagemaker.ListNotebookInstancesInput{},
+		func(page *sagemaker.ListNotebookInstancesOutput, lastPage bool) bool {
+			for _, summary := range page.NotebookInstances {
+				notebook, err := client.DescribeNotebookInstanceWithContext(ctx, &sagemaker.DescribeNotebookInstanceInput{
+					NotebookInstanceName: summary.NotebookInstanceName,
+				})
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+
+				resource, err := NewResource(*notebook.NotebookInstanceArn, notebook)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "notebook-instance"
+				resource.Metadata["InstanceType"] = aws.StringValue(notebook.InstanceType)
+				resource.Metadata["RoleArn"] = aws.StringValue(notebook.RoleArn)
+				resource.Metadata["SubnetId"] = aws.StringValue(notebook.SubnetId)
+				resource.Metadata["KmsKeyId"] = aws.StringValue(notebook.KmsKeyId)
+				resource.Metadata["InternetAccess"] = aws.StringValue(notebook.DirectInternetAccess) == sagemaker.DirectInternetAccessEnabled
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
+
+func SageMakerListEndpoints(ctx context.Context, session *Session) *ReportResult {
+	client := session.SageMakerClient()
+
+	result := &ReportResult{}
+	err := client.ListEndpointsPagesWithContext(ctx, &sagemaker.ListEndpointsInput{},
+		func(page *sagemaker.ListEndpointsOutput, lastPage bool) bool {
+			for _, summary := range page.Endpoints {
+				endpoint, err := client.DescribeEndpointWithContext(ctx, &sagemaker.DescribeEndpointInput{
+					EndpointName: summary.EndpointName,
+				})
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+
+				resource, err := NewResource(*endpoint.EndpointArn, endpoint)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "endpoint"
+
+				err = sageMakerEndpointConfig(ctx, client, endpoint.EndpointConfigName, resource.Metadata)
+				if err != nil {
+					result.AddError(err)
+					resource.Metadata["Error"] = err.Error()
+				}
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
+
+// sageMakerEndpointConfig adds the instance types and KMS key of the endpoint
+// config, and the roles and networking of its models. The model containers
+// are left out as their environment can hold secrets.
+func sageMakerEndpointConfig(ctx context.Context, client *sagemaker.SageMaker, configName *string, metadata map[string]interface{}) error {
+	config, err := client.DescribeEndpointConfigWithContext(ctx, &sagemaker.DescribeEndpointConfigInput{
+		EndpointConfigName: configName,
+	})
+	if err != nil {
+		return err
+	}
+	metadata["KmsKeyId"] = aws.StringValue(config.KmsKeyId)
+
+	instanceTypes := map[string]bool{}
+	models := map[string]bool{}
+	for _, variant := range config.ProductionVariants {
+		if variant.InstanceType != nil {
+			instanceTypes[*variant.InstanceType] = true
+		}
+		if variant.ModelName != nil {
+			models[*variant.ModelName] = true
+		}
+	}
+	metadata["InstanceTypes"] = sortedStrings(instanceTypes)
+
+	roles := map[string]bool{}
+	modelsMetadata := []map[string]interface{}{}
+	for _, name := range sortedStrings(models) {
+		model, err := client.DescribeModelWithContext(ctx, &sagemaker.DescribeModelInput{ModelName: aws.String(name)})
+		if err != nil {
+			return err
+		}
+		modelMetadata := map[string]interface{}{
+			"ModelName":              name,
+			"ModelArn":               aws.StringValue(model.ModelArn),
+			"ExecutionRoleArn":       aws.StringValue(model.ExecutionRoleArn),
+			"EnableNetworkIsolation": aws.BoolValue(model.EnableNetworkIsolation),
+		}
+		if model.VpcConfig != nil {
+			modelMetadata["VpcConfig"] = structs.Map(model.VpcConfig)
+		}
+		modelsMetadata = append(modelsMetadata, modelMetadata)
+		if model.ExecutionRoleArn != nil {
+			roles[*model.ExecutionRoleArn] = true
+		}
+	}
+	metadata["Models"] = modelsMetadata
+	metadata["ExecutionRoleArns"] = sortedStrings(roles)
+	return nil
+}
+
+func sortedStrings(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
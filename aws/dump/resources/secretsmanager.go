@@ -0,0 +1,62 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+)
+
+var (
+	SecretsManagerService = Service{
+		Name: "secretsmanager",
+		Reports: map[string]Report{
+			"secrets": SMListSecrets,
+		},
+	}
+)
+
+func init() {
+	RegisterService(SecretsManagerService)
+}
+
+func SMListSecrets(ctx context.Context, session *Session) *ReportResult {
+	client := session.SecretsManagerClient()
+
+	result := &ReportResult{}
+	err := client.ListSecretsPagesWithContext(ctx, &secretsmanager.ListSecretsInput{},
+		func(page *secretsmanager.ListSecretsOutput, lastPage bool) bool {
+			for _, secret := range page.SecretList {
+				resource, err := NewResource(*secret.ARN, secret)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "secret"
+				// Only the secret metadata is listed, GetSecretValue is never called.
+				resource.Metadata["ValueRedacted"] = true
+
+				policy, err := client.GetResourcePolicyWithContext(ctx, &secretsmanager.GetResourcePolicyInput{
+					SecretId: secret.ARN,
+				})
+				if err != nil {
+					result.AddError(err)
+					resource.Metadata["Error"] = err.Error()
+				} else if policy.ResourcePolicy != nil {
+					document, err := DecodeInlinePolicyDocument(*policy.ResourcePolicy)
+					if err != nil {
+						result.AddError(err)
+					} else {
+						resource.Metadata["Policy"] = document
+					}
+				} else {
+					resource.Metadata["Policy"] = nil
+				}
+
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
@@ -3,28 +3,90 @@ package resources
 import (
 	"fmt"
 	"sort"
+	"sync"
 )
 
-func AllServices() map[string]Service {
-	return map[string]Service{
-		"acm":         ACMService,
-		"autoscaling": AutoScalingService,
-		"cloudwatch":  CloudwatchService,
-		"ec2":         EC2Service,
-		"iam":         IAMService,
-		"kms":         KMSService,
-		"lambda":      LambdaService,
-		"route53":     Route53Service,
-		"s3":          S3Service,
-		"rds":         RDSService,
+var (
+	servicesMutex sync.RWMutex
+	services      = map[string]Service{}
+)
+
+func RegisterService(s Service) {
+	servicesMutex.Lock()
+	defer servicesMutex.Unlock()
+
+	if _, ok := services[s.Name]; ok {
+		panic(fmt.Sprintf("service %s registered twice", s.Name))
+	}
+	services[s.Name] = s
+}
+
+// RegisterReport adds a report to an already registered service so packages
+// outside this one can extend it from their init().
+func RegisterReport(serviceName, reportName string, fn Report) error {
+	servicesMutex.Lock()
+	defer servicesMutex.Unlock()
+
+	service, ok := services[serviceName]
+	if !ok {
+		return fmt.Errorf("unknown service %s", serviceName)
+	}
+	if _, ok := service.Reports[reportName]; ok {
+		return fmt.Errorf("report %s already registered for service %s", reportName, serviceName)
+	}
+
+	// copy the map so callers holding the previous Service value are not
+	// affected by the new report
+	reports := make(map[string]Report, len(service.Reports)+1)
+	for name, report := range service.Reports {
+		reports[name] = report
+	}
+	reports[reportName] = fn
+	service.Reports = reports
+	services[serviceName] = service
+	return nil
+}
+
+func GetService(name string) (Service, bool) {
+	servicesMutex.RLock()
+	defer servicesMutex.RUnlock()
+
+	service, ok := services[name]
+	return service, ok
+}
+
+func AllServices() []Service {
+	servicesMutex.RLock()
+	defer servicesMutex.RUnlock()
+
+	all := make([]Service, 0, len(services))
+	for _, service := range services {
+		all = append(all, service)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].Name < all[j].Name
+	})
+	return all
+}
+
+func ListAvailableReports() map[string][]string {
+	reports := map[string][]string{}
+	for _, service := range AllServices() {
+		names := make([]string, 0, len(service.Reports))
+		for name := range service.Reports {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		reports[service.Name] = names
 	}
+	return reports
 }
 
 func AllReports() []string {
 	reports := []string{}
-	for _, service := range AllServices() {
-		for reportName, _ := range service.Reports {
-			reports = append(reports, fmt.Sprintf("%s:%s", service.Name, reportName))
+	for service, names := range ListAvailableReports() {
+		for _, name := range names {
+			reports = append(reports, fmt.Sprintf("%s:%s", service, name))
 		}
 	}
 	sort.Strings(reports)
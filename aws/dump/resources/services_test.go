@@ -0,0 +1,25 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterReport(t *testing.T) {
+	t.Parallel()
+
+	RegisterService(Service{Name: "test-register-report", Reports: map[string]Report{}})
+
+	report := func(ctx context.Context, session *Session) *ReportResult {
+		return &ReportResult{}
+	}
+	require.NoError(t, RegisterReport("test-register-report", "custom", report))
+	require.Error(t, RegisterReport("test-register-report", "custom", report))
+	require.Error(t, RegisterReport("test-unknown-service", "custom", report))
+
+	service, ok := GetService("test-register-report")
+	require.True(t, ok)
+	require.Contains(t, service.Reports, "custom")
+}
@@ -0,0 +1,65 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/service/sfn"
+)
+
+var (
+	SFNService = Service{
+		Name: "states",
+		Reports: map[string]Report{
+			"state-machines": SFNListStateMachines,
+		},
+	}
+)
+
+func init() {
+	RegisterService(SFNService)
+}
+
+func SFNListStateMachines(ctx context.Context, session *Session) *ReportResult {
+	client := session.SFNClient()
+
+	result := &ReportResult{}
+	err := client.ListStateMachinesPagesWithContext(ctx, &sfn.ListStateMachinesInput{},
+		func(page *sfn.ListStateMachinesOutput, lastPage bool) bool {
+			for _, item := range page.StateMachines {
+				stateMachine, err := client.DescribeStateMachineWithContext(ctx, &sfn.DescribeStateMachineInput{
+					StateMachineArn: item.StateMachineArn,
+				})
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+
+				resource, err := NewResource(*stateMachine.StateMachineArn, stateMachine)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "state-machine"
+
+				if stateMachine.Definition != nil {
+					definition := struct {
+						States map[string]interface{}
+					}{}
+					err := json.Unmarshal([]byte(*stateMachine.Definition), &definition)
+					if err != nil {
+						result.AddError(err)
+					} else {
+						resource.Metadata["StateCount"] = len(definition.States)
+					}
+				}
+
+				result.Resources = append(result.Resources, *resource)
+			}
+
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
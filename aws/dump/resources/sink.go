@@ -0,0 +1,63 @@
+package resources
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+type ResourceSink interface {
+	Emit(Resource) error
+}
+
+type flusher interface {
+	Flush() error
+}
+
+type syncer interface {
+	Sync() error
+}
+
+type NDJSONSink struct {
+	mutex   sync.Mutex
+	writer  io.Writer
+	encoder *json.Encoder
+	// flushOnEmit flushes the writer after each resource so it can be
+	// tailed, compressed sinks only flush when closed.
+	flushOnEmit bool
+}
+
+func NewNDJSONSink(w io.Writer) *NDJSONSink {
+	return &NDJSONSink{
+		writer:      w,
+		encoder:     json.NewEncoder(w),
+		flushOnEmit: true,
+	}
+}
+
+func (s *NDJSONSink) Emit(resource Resource) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	// Encode writes the whole line in a single call so readers tailing the
+	// output never see a partial object.
+	err := s.encoder.Encode(resource.Normalized())
+	if err != nil {
+		return err
+	}
+
+	if !s.flushOnEmit {
+		return nil
+	}
+	return s.flush()
+}
+
+func (s *NDJSONSink) flush() error {
+	if f, ok := s.writer.(flusher); ok {
+		return f.Flush()
+	}
+	if f, ok := s.writer.(syncer); ok {
+		return f.Sync()
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/fatih/structs"
+)
+
+var (
+	SNSService = Service{
+		Name: "sns",
+		Reports: map[string]Report{
+			"topics": SNSListTopics,
+		},
+	}
+)
+
+func init() {
+	RegisterService(SNSService)
+}
+
+func SNSListTopics(ctx context.Context, session *Session) *ReportResult {
+	client := session.SNSClient()
+
+	result := &ReportResult{}
+	err := client.ListTopicsPagesWithContext(ctx, &sns.ListTopicsInput{},
+		func(page *sns.ListTopicsOutput, lastPage bool) bool {
+			for _, topic := range page.Topics {
+				resource, err := NewResource(*topic.TopicArn, topic)
+				if err != nil {
+					result.AddError(err)
+					return false
+				}
+				resource.ID = *topic.TopicArn
+				resource.Type = "topic"
+				resource.Region = *session.Config.Region
+
+				attributes, err := client.GetTopicAttributesWithContext(ctx, &sns.GetTopicAttributesInput{TopicArn: topic.TopicArn})
+				if err != nil {
+					// keep unreadable topics so they can be told apart from missing ones
+					result.AddError(err)
+					resource.Metadata["Error"] = err.Error()
+					result.Resources = append(result.Resources, *resource)
+					continue
+				}
+
+				for name, value := range attributes.Attributes {
+					resource.Metadata[name] = *value
+				}
+
+				if policy, ok := attributes.Attributes["Policy"]; ok && policy != nil {
+					document, err := DecodeInlinePolicyDocument(*policy)
+					if err != nil {
+						result.AddError(err)
+					} else {
+						resource.Metadata["Policy"] = document
+					}
+				}
+
+				subscriptions := []map[string]interface{}{}
+				err = client.ListSubscriptionsByTopicPagesWithContext(ctx, &sns.ListSubscriptionsByTopicInput{TopicArn: topic.TopicArn},
+					func(page *sns.ListSubscriptionsByTopicOutput, lastPage bool) bool {
+						for _, subscription := range page.Subscriptions {
+							subscriptions = append(subscriptions, structs.Map(subscription))
+						}
+						return true
+					})
+				if err != nil {
+					result.AddError(err)
+				}
+				resource.Metadata["Subscriptions"] = subscriptions
+
+				result.Resources = append(result.Resources, *resource)
+			}
+
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
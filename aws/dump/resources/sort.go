@@ -0,0 +1,22 @@
+package resources
+
+import "sort"
+
+// SortResources orders resources by ARN, resources without one are ordered
+// by ID.
+func SortResources(resources []Resource) {
+	sort.SliceStable(resources, func(i, j int) bool {
+		left, right := resources[i].UniqueID(), resources[j].UniqueID()
+		if left != right {
+			return left < right
+		}
+		if resources[i].Type != resources[j].Type {
+			return resources[i].Type < resources[j].Type
+		}
+		return resources[i].Region < resources[j].Region
+	})
+}
+
+func (r *ReportResult) SortByARN() {
+	SortResources(r.Resources)
+}
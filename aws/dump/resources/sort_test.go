@@ -0,0 +1,25 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortByARN(t *testing.T) {
+	t.Parallel()
+
+	result := &ReportResult{Resources: []Resource{
+		{ARN: "arn:aws:s3:::b"},
+		{ID: "zone"},
+		{ARN: "arn:aws:s3:::a"},
+		{ID: "AKIA"},
+	}}
+	result.SortByARN()
+
+	ids := []string{}
+	for _, resource := range result.Resources {
+		ids = append(ids, resource.UniqueID())
+	}
+	require.Equal(t, []string{"AKIA", "arn:aws:s3:::a", "arn:aws:s3:::b", "zone"}, ids)
+}
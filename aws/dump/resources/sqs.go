@@ -0,0 +1,90 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+)
+
+var (
+	SQSService = Service{
+		Name: "sqs",
+		Reports: map[string]Report{
+			"queues": SQSListQueues,
+		},
+	}
+)
+
+func init() {
+	RegisterService(SQSService)
+}
+
+func SQSListQueues(ctx context.Context, session *Session) *ReportResult {
+	client := session.SQSClient()
+
+	result := &ReportResult{}
+	err := client.ListQueuesPagesWithContext(ctx, &sqs.ListQueuesInput{
+		// NextToken is only returned when MaxResults is set
+		MaxResults: aws.Int64(1000),
+	},
+		func(page *sqs.ListQueuesOutput, lastPage bool) bool {
+			for _, queueURL := range page.QueueUrls {
+				attributes, err := client.GetQueueAttributesWithContext(ctx, &sqs.GetQueueAttributesInput{
+					QueueUrl:       queueURL,
+					AttributeNames: []*string{aws.String(sqs.QueueAttributeNameAll)},
+				})
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+
+				metadata := map[string]interface{}{
+					"QueueUrl": *queueURL,
+				}
+				for name, value := range attributes.Attributes {
+					metadata[name] = *value
+				}
+
+				if policy, ok := attributes.Attributes[sqs.QueueAttributeNamePolicy]; ok {
+					document, err := DecodeInlinePolicyDocument(*policy)
+					if err != nil {
+						result.AddError(err)
+					} else {
+						metadata[sqs.QueueAttributeNamePolicy] = document
+					}
+				}
+
+				if redrivePolicy, ok := attributes.Attributes[sqs.QueueAttributeNameRedrivePolicy]; ok {
+					decoded := map[string]interface{}{}
+					err := json.Unmarshal([]byte(*redrivePolicy), &decoded)
+					if err != nil {
+						result.AddError(err)
+					} else {
+						metadata[sqs.QueueAttributeNameRedrivePolicy] = decoded
+					}
+				}
+
+				arn, ok := attributes.Attributes[sqs.QueueAttributeNameQueueArn]
+				if !ok {
+					continue
+				}
+
+				resource, err := NewResource(*arn, nil)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "queue"
+				resource.Region = *session.Config.Region
+				resource.Metadata = metadata
+				result.Resources = append(result.Resources, *resource)
+			}
+
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
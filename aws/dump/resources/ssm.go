@@ -0,0 +1,55 @@
+package resources
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/fatih/structs"
+)
+
+var (
+	SSMService = Service{
+		Name: "ssm",
+		Reports: map[string]Report{
+			"parameters": SSMListParameters,
+		},
+	}
+)
+
+func init() {
+	RegisterService(SSMService)
+}
+
+func SSMParameterARN(partition, region, accountID, name string) string {
+	return BuildARN(partition, "ssm", region, accountID, "parameter/"+strings.TrimPrefix(name, "/"))
+}
+
+func SSMListParameters(ctx context.Context, session *Session) *ReportResult {
+	client := session.SSMClient()
+
+	result := &ReportResult{}
+	err := client.DescribeParametersPagesWithContext(ctx, &ssm.DescribeParametersInput{},
+		func(page *ssm.DescribeParametersOutput, lastPage bool) bool {
+			for _, parameter := range page.Parameters {
+				resource := Resource{
+					ID:        *parameter.Name,
+					ARN:       SSMParameterARN(session.Partition(), *session.Config.Region, session.AccountID, *parameter.Name),
+					AccountID: session.AccountID,
+					Service:   "ssm",
+					Type:      "parameter",
+					Region:    *session.Config.Region,
+					Metadata:  structs.Map(parameter),
+				}
+				// Only the parameter metadata is listed, GetParameter is never called.
+				if *parameter.Type == ssm.ParameterTypeSecureString {
+					resource.Metadata["ValueRedacted"] = true
+				}
+				result.Resources = append(result.Resources, resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
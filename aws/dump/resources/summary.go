@@ -0,0 +1,51 @@
+package resources
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+)
+
+func Summarize(results map[string]*ReportResult) map[string]map[string]int {
+	summary := map[string]map[string]int{}
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+		for _, resource := range result.Resources {
+			types, ok := summary[resource.Service]
+			if !ok {
+				types = map[string]int{}
+				summary[resource.Service] = types
+			}
+			types[resource.Type]++
+		}
+	}
+	return summary
+}
+
+func SummaryTable(w io.Writer, results map[string]*ReportResult) error {
+	summary := Summarize(results)
+
+	services := make([]string, 0, len(summary))
+	for service := range summary {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+
+	writer := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "SERVICE\tTYPE\tCOUNT")
+	for _, service := range services {
+		types := make([]string, 0, len(summary[service]))
+		for resourceType := range summary[service] {
+			types = append(types, resourceType)
+		}
+		sort.Strings(types)
+
+		for _, resourceType := range types {
+			fmt.Fprintf(writer, "%s\t%s\t%d\n", service, resourceType, summary[service][resourceType])
+		}
+	}
+	return writer.Flush()
+}
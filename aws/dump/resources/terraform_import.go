@@ -0,0 +1,91 @@
+package resources
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// DefaultTerraformResourceTypes maps service:type to the Terraform resource
+// type used by GenerateTerraformImports.
+var DefaultTerraformResourceTypes = map[string]string{
+	"iam:user":           "aws_iam_user",
+	"iam:role":           "aws_iam_role",
+	"iam:policy":         "aws_iam_policy",
+	"ec2:instance":       "aws_instance",
+	"ec2:security-group": "aws_security_group",
+}
+
+var (
+	// import IDs differ from the resource IDs for these types
+	terraformImportIDs = map[string]func(Resource) string{
+		"aws_iam_user":   func(r Resource) string { return metadataString(r, "UserName") },
+		"aws_iam_role":   func(r Resource) string { return metadataString(r, "RoleName") },
+		"aws_iam_policy": func(r Resource) string { return r.ARN },
+	}
+
+	terraformInvalidCharacters = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+)
+
+func metadataString(resource Resource, key string) string {
+	value, _ := NormalizeValue(resource.Metadata[key]).(string)
+	return value
+}
+
+// terraformName turns the Name tag, or the ID, into a valid identifier.
+func terraformName(resource Resource) string {
+	name := extractTags(resource.Metadata)["Name"]
+	if name == "" {
+		name = resource.ID
+		if i := strings.LastIndex(name, "/"); i >= 0 {
+			name = name[i+1:]
+		}
+	}
+
+	name = strings.Trim(terraformInvalidCharacters.ReplaceAllString(name, "_"), "_")
+	if name == "" {
+		return "resource"
+	}
+	if first := name[0]; !(first == '_' || (first >= 'a' && first <= 'z') || (first >= 'A' && first <= 'Z')) {
+		name = "_" + name
+	}
+	return name
+}
+
+// GenerateTerraformImports returns the terraform import commands for the
+// resources of result mapped in resourceTypeMap, DefaultTerraformResourceTypes
+// is used when it is nil. Resources of other types are ignored.
+func GenerateTerraformImports(result *ReportResult, resourceTypeMap map[string]string) ([]string, error) {
+	if resourceTypeMap == nil {
+		resourceTypeMap = DefaultTerraformResourceTypes
+	}
+
+	commands := []string{}
+	used := map[string]int{}
+	for _, resource := range result.Resources {
+		terraformType, ok := resourceTypeMap[fmt.Sprintf("%s:%s", resource.Service, resource.Type)]
+		if !ok {
+			continue
+		}
+
+		importID := resource.ID
+		if fn, ok := terraformImportIDs[terraformType]; ok {
+			importID = fn(resource)
+		}
+		if importID == "" {
+			return nil, fmt.Errorf("no import ID for %s %s", terraformType, resource.UniqueID())
+		}
+
+		name := terraformName(resource)
+		address := fmt.Sprintf("%s.%s", terraformType, name)
+		used[address]++
+		if used[address] > 1 {
+			address = fmt.Sprintf("%s_%d", address, used[address])
+		}
+
+		commands = append(commands, fmt.Sprintf("terraform import %s %s", address, importID))
+	}
+	sort.Strings(commands)
+	return commands, nil
+}
@@ -0,0 +1,36 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateTerraformImports(t *testing.T) {
+	t.Parallel()
+
+	result := &ReportResult{Resources: []Resource{
+		{ID: "user/admin", ARN: "arn:aws:iam::123456789012:user/admin", Service: "iam", Type: "user", Metadata: map[string]interface{}{"UserName": aws.String("admin")}},
+		{ID: "policy/deploy", ARN: "arn:aws:iam::123456789012:policy/deploy", Service: "iam", Type: "policy", Metadata: map[string]interface{}{}},
+		{ID: "i-1", Service: "ec2", Type: "instance", Metadata: map[string]interface{}{"TagsMap": map[string]string{"Name": "web server.1"}}},
+		{ID: "i-2", Service: "ec2", Type: "instance", Metadata: map[string]interface{}{"TagsMap": map[string]string{"Name": "web server.1"}}},
+		{ID: "sg-1", Service: "ec2", Type: "security-group", Metadata: map[string]interface{}{}},
+		{ID: "1234", Service: "unknown", Type: "thing", Metadata: map[string]interface{}{}},
+	}}
+
+	commands, err := GenerateTerraformImports(result, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"terraform import aws_iam_policy.deploy arn:aws:iam::123456789012:policy/deploy",
+		"terraform import aws_iam_user.admin admin",
+		"terraform import aws_instance.web_server_1 i-1",
+		"terraform import aws_instance.web_server_1_2 i-2",
+		"terraform import aws_security_group.sg-1 sg-1",
+	}, commands)
+
+	_, err = GenerateTerraformImports(&ReportResult{Resources: []Resource{
+		{ID: "role/missing", Service: "iam", Type: "role", Metadata: map[string]interface{}{}},
+	}}, nil)
+	require.Error(t, err)
+}
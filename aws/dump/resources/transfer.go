@@ -0,0 +1,83 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/fatih/structs"
+)
+
+var (
+	TransferService = Service{
+		Name: "transfer",
+		Reports: map[string]Report{
+			"servers": TransferListServers,
+		},
+	}
+)
+
+func init() {
+	RegisterService(TransferService)
+}
+
+func TransferListServers(ctx context.Context, session *Session) *ReportResult {
+	client := session.TransferClient()
+
+	result := &ReportResult{}
+	err := client.ListServersPagesWithContext(ctx, &transfer.ListServersInput{},
+		func(page *transfer.ListServersOutput, lastPage bool) bool {
+			for _, listed := range page.Servers {
+				described, err := client.DescribeServerWithContext(ctx, &transfer.DescribeServerInput{ServerId: listed.ServerId})
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				server := described.Server
+
+				resource, err := NewResource(*server.Arn, server)
+				if err != nil {
+					result.AddError(err)
+					continue
+				}
+				resource.Type = "server"
+
+				protocols := aws.StringValueSlice(server.Protocols)
+				resource.Metadata["Protocols"] = protocols
+				resource.Metadata["InsecureProtocol"] = containsString(protocols, transfer.ProtocolFtp)
+				resource.Metadata["EndpointType"] = aws.StringValue(server.EndpointType)
+				resource.Metadata["IdentityProviderType"] = aws.StringValue(server.IdentityProviderType)
+				resource.Metadata["LoggingRole"] = aws.StringValue(server.LoggingRole)
+
+				// VPC endpoints are internet facing once elastic IPs are attached
+				public := aws.StringValue(server.EndpointType) == transfer.EndpointTypePublic
+				if server.EndpointDetails != nil && len(server.EndpointDetails.AddressAllocationIds) > 0 {
+					public = true
+				}
+				resource.Metadata["PubliclyExposed"] = public
+
+				// only service managed servers store users in Transfer
+				if aws.StringValue(server.IdentityProviderType) == transfer.IdentityProviderTypeServiceManaged {
+					users := []map[string]interface{}{}
+					err = client.ListUsersPagesWithContext(ctx, &transfer.ListUsersInput{ServerId: server.ServerId},
+						func(page *transfer.ListUsersOutput, lastPage bool) bool {
+							for _, user := range page.Users {
+								users = append(users, structs.Map(user))
+							}
+							return true
+						})
+					if err != nil {
+						result.AddError(err)
+						resource.Metadata["Error"] = err.Error()
+					}
+					resource.Metadata["Users"] = users
+				}
+
+				result.Resources = append(result.Resources, *resource)
+			}
+			return true
+		})
+
+	result.AddError(err)
+	return result
+}
@@ -0,0 +1,107 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+// UploadResultsToS3 uploads each report as NDJSON under
+// keyPrefix/<service>/<report>-<timestamp>.ndjson, results are keyed by
+// service:report as returned by RunReports.
+func UploadResultsToS3(ctx context.Context, session *Session, bucket, keyPrefix string, results map[string]*ReportResult) error {
+	region, err := s3manager.GetBucketRegion(ctx, session.Session, bucket, *session.Config.Region)
+	if err != nil {
+		return err
+	}
+	bucketSession := session.ForRegion(region)
+	client := bucketSession.S3Client()
+
+	encryption, err := s3BucketEncryption(ctx, client, bucket)
+	if IsAWSErrorCode(err, "AccessDenied") {
+		// uploading only needs s3:PutObject, the bucket default encryption
+		// still applies when it can't be read
+		session.logger().Warnf("can't read the encryption of bucket %s, uploading without explicit encryption: %s", bucket, err)
+	} else if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(results))
+	for name := range results {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	timestamp := time.Now().UTC().Format("20060102T150405Z")
+	uploader := s3manager.NewUploaderWithClient(client)
+	errors := &MultiError{}
+	for _, name := range names {
+		result := results[name]
+		if result == nil {
+			continue
+		}
+
+		body := &bytes.Buffer{}
+		sink := NewNDJSONSink(body)
+		for _, resource := range result.Resources {
+			if err := sink.Emit(resource); err != nil {
+				return err
+			}
+		}
+
+		input := &s3manager.UploadInput{
+			Bucket:      aws.String(bucket),
+			Key:         aws.String(uploadKey(keyPrefix, name, timestamp)),
+			Body:        body,
+			ContentType: aws.String("application/x-ndjson"),
+		}
+		if encryption != nil {
+			input.ServerSideEncryption = encryption.SSEAlgorithm
+			input.SSEKMSKeyId = encryption.KMSMasterKeyID
+		}
+
+		_, err := uploader.UploadWithContext(ctx, input)
+		if err != nil {
+			errors.Append(fmt.Errorf("%s: %w", name, err))
+		}
+	}
+
+	return errors.ErrorOrNil()
+}
+
+func uploadKey(keyPrefix, name, timestamp string) string {
+	service, report := "", name
+	if parts := strings.SplitN(name, ":", 2); len(parts) == 2 {
+		service, report = parts[0], parts[1]
+	}
+	return path.Join(keyPrefix, service, fmt.Sprintf("%s-%s.ndjson", report, timestamp))
+}
+
+// s3BucketEncryption returns the default encryption of the bucket, nil when
+// the bucket is unencrypted.
+func s3BucketEncryption(ctx context.Context, client *s3.S3, bucket string) (*s3.ServerSideEncryptionByDefault, error) {
+	encryption, err := client.GetBucketEncryptionWithContext(ctx, &s3.GetBucketEncryptionInput{
+		Bucket: aws.String(bucket),
+	})
+	if IsAWSErrorCode(err, "ServerSideEncryptionConfigurationNotFoundError") {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rule := range encryption.ServerSideEncryptionConfiguration.Rules {
+		if rule.ApplyServerSideEncryptionByDefault != nil {
+			return rule.ApplyServerSideEncryptionByDefault, nil
+		}
+	}
+	return nil, nil
+}
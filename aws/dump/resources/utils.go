@@ -4,9 +4,23 @@ import (
 	"encoding/json"
 	"net/url"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/pkg/errors"
 )
 
+func IsAWSErrorCode(err error, codes ...string) bool {
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		return false
+	}
+	for _, code := range codes {
+		if aerr.Code() == code {
+			return true
+		}
+	}
+	return false
+}
+
 func DecodeInlinePolicyDocument(inlineDocument string) (map[string]interface{}, error) {
 	decodedDocument, err := url.QueryUnescape(inlineDocument)
 	if err != nil {
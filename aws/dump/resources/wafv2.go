@@ -0,0 +1,160 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+)
+
+var (
+	WAFv2Service = Service{
+		Name: "wafv2",
+		Reports: map[string]Report{
+			"web-acls": WAFListWebACLs,
+		},
+	}
+)
+
+func init() {
+	RegisterService(WAFv2Service)
+}
+
+// CloudFront web ACLs are global but can only be listed from us-east-1.
+const wafCloudFrontRegion = "us-east-1"
+
+func WAFListWebACLs(ctx context.Context, session *Session) *ReportResult {
+	client := session.WAFV2Client()
+
+	scopes := []string{wafv2.ScopeRegional}
+	if *session.Config.Region == wafCloudFrontRegion {
+		scopes = append(scopes, wafv2.ScopeCloudfront)
+	}
+
+	result := &ReportResult{}
+	for _, scope := range scopes {
+		input := &wafv2.ListWebACLsInput{Scope: aws.String(scope)}
+		for {
+			page, err := client.ListWebACLsWithContext(ctx, input)
+			if err != nil {
+				result.AddError(err)
+				break
+			}
+
+			for _, summary := range page.WebACLs {
+				resource, err := wafDescribeWebACL(ctx, client, summary, scope)
+				result.AddError(err)
+				if resource != nil {
+					result.Resources = append(result.Resources, *resource)
+				}
+			}
+
+			if page.NextMarker == nil || *page.NextMarker == "" {
+				break
+			}
+			input.NextMarker = page.NextMarker
+		}
+	}
+
+	return result
+}
+
+// wafDescribeWebACL returns the resource along with the error when only the
+// associated resources couldn't be listed.
+func wafDescribeWebACL(ctx context.Context, client *wafv2.WAFV2, summary *wafv2.WebACLSummary, scope string) (*Resource, error) {
+	webACL, err := client.GetWebACLWithContext(ctx, &wafv2.GetWebACLInput{
+		Id:    summary.Id,
+		Name:  summary.Name,
+		Scope: aws.String(scope),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := NewResource(*summary.ARN, webACL.WebACL)
+	if err != nil {
+		return nil, err
+	}
+	resource.Type = "web-acl"
+	resource.Metadata["Scope"] = scope
+
+	if webACL.WebACL.DefaultAction != nil && webACL.WebACL.DefaultAction.Block != nil {
+		resource.Metadata["DefaultAction"] = "block"
+	} else {
+		resource.Metadata["DefaultAction"] = "allow"
+	}
+
+	rules := []map[string]interface{}{}
+	for _, rule := range webACL.WebACL.Rules {
+		rules = append(rules, wafNormalizeRule(rule))
+	}
+	resource.Metadata["NormalizedRules"] = rules
+
+	if scope == wafv2.ScopeRegional {
+		associated, err := client.ListResourcesForWebACLWithContext(ctx, &wafv2.ListResourcesForWebACLInput{
+			WebACLArn: summary.ARN,
+		})
+		if err != nil {
+			// the web ACL itself was read, keep it
+			resource.Metadata["Error"] = err.Error()
+			return resource, err
+		}
+		resource.Metadata["AssociatedResources"] = aws.StringValueSlice(associated.ResourceArns)
+	}
+
+	return resource, nil
+}
+
+func wafNormalizeRule(rule *wafv2.Rule) map[string]interface{} {
+	normalized := map[string]interface{}{
+		"Name":     aws.StringValue(rule.Name),
+		"Priority": aws.Int64Value(rule.Priority),
+		"Type":     "custom",
+	}
+
+	if action := rule.Action; action != nil {
+		switch {
+		case action.Allow != nil:
+			normalized["Action"] = "allow"
+		case action.Block != nil:
+			normalized["Action"] = "block"
+		case action.Count != nil:
+			normalized["Action"] = "count"
+		case action.Captcha != nil:
+			normalized["Action"] = "captcha"
+		case action.Challenge != nil:
+			normalized["Action"] = "challenge"
+		}
+	}
+	if action := rule.OverrideAction; action != nil {
+		switch {
+		case action.None != nil:
+			normalized["Action"] = "none"
+		case action.Count != nil:
+			normalized["Action"] = "count"
+		}
+	}
+
+	statement := rule.Statement
+	if statement == nil {
+		return normalized
+	}
+	switch {
+	case statement.RateBasedStatement != nil:
+		normalized["Type"] = "rate-based"
+		normalized["Limit"] = aws.Int64Value(statement.RateBasedStatement.Limit)
+		normalized["AggregateKeyType"] = aws.StringValue(statement.RateBasedStatement.AggregateKeyType)
+	case statement.ManagedRuleGroupStatement != nil:
+		normalized["Type"] = "managed-rule-group"
+		normalized["VendorName"] = aws.StringValue(statement.ManagedRuleGroupStatement.VendorName)
+		normalized["RuleGroupName"] = aws.StringValue(statement.ManagedRuleGroupStatement.Name)
+		normalized["Version"] = aws.StringValue(statement.ManagedRuleGroupStatement.Version)
+	case statement.RuleGroupReferenceStatement != nil:
+		normalized["Type"] = "rule-group"
+		normalized["RuleGroupArn"] = aws.StringValue(statement.RuleGroupReferenceStatement.ARN)
+	case statement.IPSetReferenceStatement != nil:
+		normalized["Type"] = "ip-set"
+		normalized["IPSetArn"] = aws.StringValue(statement.IPSetReferenceStatement.ARN)
+	}
+	return normalized
+}